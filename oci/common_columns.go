@@ -5,6 +5,8 @@ import (
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
@@ -20,6 +22,8 @@ const (
 	ColumnDescriptionCompartment = "The OCID of the compartment in Tenant in which the resource is located."
 	ColumnDescriptionRegion      = "The OCI region in which the resource is located."
 
+	ColumnDescriptionCompartmentPath = "The path of the compartment in which the resource is located, e.g. root/prod/network."
+
 	// Other repetitive columns for the provider
 	ColumnDescriptionFreefromTags = "Free-form tags for resource. This tags can be applied by any user with permissions on the resource."
 	ColumnDescriptionDefinedTags  = "Defined tags for resource. Defined tags are set up in your tenancy by an administrator. Only users granted permission to work with the defined tags can apply them to resources."
@@ -27,7 +31,7 @@ const (
 )
 
 func commonColumnsForAllResource(columns []*plugin.Column) []*plugin.Column {
-	return append(columns, []*plugin.Column{
+	commonColumns := []*plugin.Column{
 		{
 			Name:        "tenant_name",
 			Type:        proto.ColumnType_STRING,
@@ -35,7 +39,85 @@ func commonColumnsForAllResource(columns []*plugin.Column) []*plugin.Column {
 			Description: ColumnDescriptionTenantName,
 			Transform:   transform.FromField("Name"),
 		},
-	}...)
+	}
+
+	// tables of resources located in a compartment also get the path of the compartment, unless they
+	// already define it. Tables whose compartment_id column has a hydrate function must declare it as a
+	// dependency of getCompartmentPath in their HydrateConfig.
+	hasCompartmentColumn := false
+	for _, column := range columns {
+		switch column.Name {
+		case "compartment_id":
+			hasCompartmentColumn = true
+		case "compartment_path":
+			return append(columns, commonColumns...)
+		}
+	}
+	if hasCompartmentColumn {
+		commonColumns = append(commonColumns, &plugin.Column{
+			Name:        "compartment_path",
+			Type:        proto.ColumnType_STRING,
+			Hydrate:     getCompartmentPath,
+			Description: ColumnDescriptionCompartmentPath,
+			Transform:   transform.FromValue(),
+		})
+	}
+
+	return append(columns, commonColumns...)
+}
+
+// getCompartmentPath returns the path of the compartment of a row, e.g. root/prod/network, or nil if the
+// compartment or one of its ancestors can't be accessed. The OCID of the compartment is evaluated the same
+// way as the compartment_id column of the table.
+func getCompartmentPath(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	var compartmentColumn *plugin.Column
+	for _, column := range d.Table.Columns {
+		if column.Name == "compartment_id" {
+			compartmentColumn = column
+			break
+		}
+	}
+	if compartmentColumn == nil {
+		return nil, nil
+	}
+
+	// the result of the hydrate function of the column is available as it is a dependency of getCompartmentPath
+	item := h.Item
+	if compartmentColumn.Hydrate != nil {
+		item = h.HydrateResults[helpers.GetFunctionName(compartmentColumn.Hydrate)]
+	}
+	if item == nil {
+		return nil, nil
+	}
+
+	// the default transform of the plugin, FromGo, looks for a CompartmentID field which the OCI SDK structs
+	// don't have, so the CompartmentId field is read instead
+	compartmentTransform := compartmentColumn.Transform
+	if compartmentTransform == nil {
+		compartmentTransform = transform.FromCamel()
+	}
+	compartmentId, err := compartmentTransform.Execute(ctx, &transform.TransformData{
+		HydrateItem:    item,
+		HydrateResults: h.HydrateResults,
+		ColumnName:     compartmentColumn.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	id := types.ToString(compartmentId)
+	if id == "" {
+		return nil, nil
+	}
+
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("getCompartmentPath", "connection_name", d.Connection.Name, "error", err)
+		return nil, err
+	}
+	if compartmentPath := tree.path(id); compartmentPath != "" {
+		return compartmentPath, nil
+	}
+	return nil, nil
 }
 
 // returns the tenant_name common column which is added across all the tables
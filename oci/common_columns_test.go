@@ -0,0 +1,49 @@
+package oci
+
+import (
+	"context"
+	"testing"
+
+	"github.com/turbot/go-kit/helpers"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// getCompartmentPath reads the result of the hydrate function of the compartment_id column, which is only
+// available if the function is declared as a dependency of getCompartmentPath
+func TestCompartmentPathDependsOnCompartmentHydrate(t *testing.T) {
+	compartmentPathFunc := helpers.GetFunctionName(getCompartmentPath)
+
+	for name, table := range Plugin(context.Background()).TableMap {
+		var compartmentColumn, pathColumn *plugin.Column
+		for _, column := range table.Columns {
+			switch column.Name {
+			case "compartment_id":
+				compartmentColumn = column
+			case "compartment_path":
+				pathColumn = column
+			}
+		}
+		if compartmentColumn == nil || compartmentColumn.Hydrate == nil || pathColumn == nil || pathColumn.Hydrate == nil {
+			continue
+		}
+		if helpers.GetFunctionName(pathColumn.Hydrate) != compartmentPathFunc {
+			continue
+		}
+
+		compartmentFunc := helpers.GetFunctionName(compartmentColumn.Hydrate)
+		found := false
+		for _, config := range table.HydrateConfig {
+			if config.Func == nil || helpers.GetFunctionName(config.Func) != compartmentPathFunc {
+				continue
+			}
+			for _, dependency := range config.Depends {
+				if helpers.GetFunctionName(dependency) == compartmentFunc {
+					found = true
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s: compartment_id is hydrated by %s, which is not a dependency of getCompartmentPath", name, compartmentFunc)
+		}
+	}
+}
@@ -5,18 +5,34 @@ import (
 )
 
 type ociConfig struct {
-	Auth                  *string  `hcl:"auth"`
-	ConfigPath            *string  `hcl:"config_path"`
-	Fingerprint           *string  `hcl:"fingerprint"`
-	PrivateKey            *string  `hcl:"private_key"`
-	PrivateKeyPassword    *string  `hcl:"private_key_password"`
-	PrivateKeyPath        *string  `hcl:"private_key_path"`
-	Profile               *string  `hcl:"config_file_profile"`
-	Regions               []string `hcl:"regions,optional"`
-	TenancyOCID           *string  `hcl:"tenancy_ocid"`
-	UserOCID              *string  `hcl:"user_ocid"`
-	MaxErrorRetryAttempts *int     `hcl:"max_error_retry_attempts"`
-	MinErrorRetryDelay    *int     `hcl:"min_error_retry_delay"`
+	Auth                    *string            `hcl:"auth"`
+	ConfigPath              *string            `hcl:"config_path"`
+	Fingerprint             *string            `hcl:"fingerprint"`
+	PrivateKey              *string            `hcl:"private_key"`
+	PrivateKeyPassword      *string            `hcl:"private_key_password"`
+	PrivateKeyPath          *string            `hcl:"private_key_path"`
+	Profile                 *string            `hcl:"config_file_profile"`
+	Regions                 []string           `hcl:"regions,optional"`
+	ExcludeRegions          []string           `hcl:"exclude_regions,optional"`
+	Compartments            []string           `hcl:"compartments,optional"`
+	ExcludeCompartments     []string           `hcl:"exclude_compartments,optional"`
+	TenancyOCID             *string            `hcl:"tenancy_ocid"`
+	UserOCID                *string            `hcl:"user_ocid"`
+	MaxErrorRetryAttempts   *int               `hcl:"max_error_retry_attempts"`
+	MinErrorRetryDelay      *int               `hcl:"min_error_retry_delay"`
+	MaxErrorRetryDuration   *int               `hcl:"max_error_retry_duration"`
+	RetryableErrors         []string           `hcl:"retryable_errors,optional"`
+	IgnoreErrorCodes        []string           `hcl:"ignore_error_codes,optional"`
+	ProxyUrl                *string            `hcl:"proxy_url"`
+	CaBundlePath            *string            `hcl:"ca_bundle_path"`
+	Endpoints               map[string]string  `hcl:"endpoints,optional"`
+	RealmDomain             *string            `hcl:"realm_domain"`
+	DedicatedRegions        map[string]string  `hcl:"dedicated_regions,optional"`
+	HttpRecordingMode       *string            `hcl:"http_recording_mode"`
+	HttpRecordingDir        *string            `hcl:"http_recording_dir"`
+	SkipUnsubscribedRegions *bool              `hcl:"skip_unsubscribed_regions"`
+	SparseCompartmentMatrix *bool              `hcl:"sparse_compartment_matrix"`
+	RateLimits              map[string]float64 `hcl:"rate_limits,optional"`
 }
 
 func ConfigInstance() interface{} {
@@ -0,0 +1,224 @@
+package oci
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Helpers of the tables of the resources of identity domains, which are listed with the SCIM API of each
+// domain, called on the URL of the domain.
+//
+// https://docs.oracle.com/en-us/iaas/Content/Identity/api-getstarted/overview.htm
+
+// identityDomainScimPageSize is the maximum number of resources returned by a SCIM list call
+const identityDomainScimPageSize = 1000
+
+// identityDomainScimAttribute maps a column to the SCIM attribute its quals are pushed down to
+type identityDomainScimAttribute struct {
+	Column    string
+	Attribute string
+}
+
+// identityDomainScimKeyColumns returns the optional domain_id key column and the key columns of the
+// columns filtered by SCIM attributes
+func identityDomainScimKeyColumns(attributes []identityDomainScimAttribute) []*plugin.KeyColumn {
+	keyColumns := []*plugin.KeyColumn{
+		{
+			Name:    "domain_id",
+			Require: plugin.Optional,
+		},
+	}
+	for _, attribute := range attributes {
+		keyColumns = append(keyColumns, &plugin.KeyColumn{
+			Name:      attribute.Column,
+			Operators: []string{"=", "<>"},
+			Require:   plugin.Optional,
+		})
+	}
+	return keyColumns
+}
+
+// buildIdentityDomainScimFilter returns the SCIM filter matching the quals of the given columns and the
+// additional filters, e.g. userName eq "jane" and active eq true, or nil if there is nothing to filter
+func buildIdentityDomainScimFilter(d *plugin.QueryData, attributes []identityDomainScimAttribute, filters ...string) *string {
+	for _, attribute := range attributes {
+		if d.Quals[attribute.Column] == nil {
+			continue
+		}
+		for _, q := range d.Quals[attribute.Column].Quals {
+			value, ok := identityDomainScimValue(q.Value)
+			if !ok {
+				continue
+			}
+			switch q.Operator {
+			case "=":
+				filters = append(filters, fmt.Sprintf("%s eq %s", attribute.Attribute, value))
+			case "<>":
+				filters = append(filters, fmt.Sprintf("%s ne %s", attribute.Attribute, value))
+			}
+		}
+	}
+
+	if len(filters) == 0 {
+		return nil
+	}
+	filter := strings.Join(filters, " and ")
+	return &filter
+}
+
+// identityDomainScimValue returns a qual value as a SCIM filter value, i.e. a JSON string, number or boolean
+func identityDomainScimValue(value *proto.QualValue) (string, bool) {
+	switch v := value.Value.(type) {
+	case *proto.QualValue_StringValue:
+		return identityDomainScimString(v.StringValue), true
+	case *proto.QualValue_BoolValue:
+		return fmt.Sprint(v.BoolValue), true
+	case *proto.QualValue_Int64Value:
+		return fmt.Sprint(v.Int64Value), true
+	}
+	return "", false
+}
+
+// identityDomainScimString quotes a string for a SCIM filter
+func identityDomainScimString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// listActiveIdentityDomains returns the active identity domains of the tenancy, listed once per connection.
+// Domains which aren't active can't be called.
+func listActiveIdentityDomains(ctx context.Context, d *plugin.QueryData) ([]identity.DomainSummary, error) {
+	cacheKey := "listActiveIdentityDomains"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]identity.DomainSummary), nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := identity.ListDomainsRequest{
+		CompartmentId:  &session.TenancyID,
+		LifecycleState: identity.DomainLifecycleStateActive,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	var domains []identity.DomainSummary
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListDomains(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, response.Items...)
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, domains)
+
+	return domains, nil
+}
+
+// listIdentityDomainsForQuery returns the active identity domains matching the domain_id qual
+func listIdentityDomainsForQuery(ctx context.Context, d *plugin.QueryData) ([]identity.DomainSummary, error) {
+	domains, err := listActiveIdentityDomains(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if d.EqualsQuals["domain_id"] == nil {
+		return domains, nil
+	}
+
+	var matched []identity.DomainSummary
+	for _, domain := range domains {
+		if *domain.Id == d.EqualsQualString("domain_id") {
+			matched = append(matched, domain)
+		}
+	}
+	return matched, nil
+}
+
+// identityDomainScimCount returns the number of resources to request in a page, at most the limit of the query
+func identityDomainScimCount(d *plugin.QueryData) int {
+	count := identityDomainScimPageSize
+	if d.QueryContext.Limit != nil && *d.QueryContext.Limit < int64(count) {
+		count = int(*d.QueryContext.Limit)
+		if count < 1 {
+			count = 1
+		}
+	}
+	return count
+}
+
+//// TRANSFORM FUNCTIONS
+
+// identityDomainFreeformTags converts the free-form tags of the OCI tags extension of a resource to a map
+func identityDomainFreeformTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ociTags, ok := d.Value.(*identitydomains.ExtensionOciTags)
+	if !ok || ociTags == nil || len(ociTags.FreeformTags) == 0 {
+		return nil, nil
+	}
+
+	tags := map[string]string{}
+	for _, tag := range ociTags.FreeformTags {
+		tags[types.SafeString(tag.Key)] = types.SafeString(tag.Value)
+	}
+	return tags, nil
+}
+
+// identityDomainDefinedTags converts the defined tags of the OCI tags extension of a resource to a map of namespaces
+func identityDomainDefinedTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ociTags, ok := d.Value.(*identitydomains.ExtensionOciTags)
+	if !ok || ociTags == nil || len(ociTags.DefinedTags) == 0 {
+		return nil, nil
+	}
+
+	tags := map[string]map[string]string{}
+	for _, tag := range ociTags.DefinedTags {
+		namespace := types.SafeString(tag.Namespace)
+		if tags[namespace] == nil {
+			tags[namespace] = map[string]string{}
+		}
+		tags[namespace][types.SafeString(tag.Key)] = types.SafeString(tag.Value)
+	}
+	return tags, nil
+}
+
+// identityDomainTags returns the free-form and defined tags of the OCI tags extension of a resource in a single map
+func identityDomainTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	ociTags, ok := d.Value.(*identitydomains.ExtensionOciTags)
+	if !ok || ociTags == nil {
+		return nil, nil
+	}
+
+	var tags map[string]interface{}
+	for _, tag := range ociTags.FreeformTags {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		tags[types.SafeString(tag.Key)] = types.SafeString(tag.Value)
+	}
+	for _, tag := range ociTags.DefinedTags {
+		if tags == nil {
+			tags = map[string]interface{}{}
+		}
+		tags[types.SafeString(tag.Key)] = types.SafeString(tag.Value)
+	}
+	return tags, nil
+}
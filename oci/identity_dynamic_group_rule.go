@@ -0,0 +1,219 @@
+package oci
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parser and evaluator of the matching rules of dynamic groups, e.g.
+//
+//	ALL {instance.compartment.id = 'ocid1.compartment.oc1..aaaa', tag.Operations.Environment.value = 'prod'}
+//	ANY {resource.type = 'fnfunc', instance.id = 'ocid1.instance.oc1..aaaa'}
+//
+// https://docs.oracle.com/en-us/iaas/Content/Identity/Tasks/managingdynamicgroups.htm
+
+const (
+	dynamicGroupRuleAll = "all"
+	dynamicGroupRuleAny = "any"
+)
+
+// dynamicGroupRule is either a set of rules matched with ALL or ANY, or a condition on an attribute of a
+// resource. Conditions without an operator match resources having the attribute, e.g. a tag with any value.
+type dynamicGroupRule struct {
+	Match     string              `json:"match,omitempty"`
+	Rules     []*dynamicGroupRule `json:"rules,omitempty"`
+	Attribute string              `json:"attribute,omitempty"`
+	Operator  string              `json:"operator,omitempty"`
+	Value     string              `json:"value,omitempty"`
+}
+
+// dynamicGroupResource is an instance or another resource which can be a member of dynamic groups
+type dynamicGroupResource struct {
+	Type          string
+	Id            string
+	CompartmentId string
+	DefinedTags   map[string]map[string]interface{}
+}
+
+// parseDynamicGroupRule parses a matching rule
+func parseDynamicGroupRule(text string) (*dynamicGroupRule, error) {
+	tokens, err := tokenizeDynamicGroupRule(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("empty matching rule")
+	}
+
+	parser := &dynamicGroupRuleParser{tokens: tokens}
+	rule, err := parser.parseRule()
+	if err != nil {
+		return nil, err
+	}
+	if parser.position < len(tokens) {
+		return nil, fmt.Errorf("unexpected %q", tokens[parser.position])
+	}
+	return rule, nil
+}
+
+// tokenizeDynamicGroupRule splits a matching rule into braces, commas, operators, quoted values and words
+func tokenizeDynamicGroupRule(text string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '{' || c == '}' || c == ',' || c == '=':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '!':
+			if i+1 >= len(text) || text[i+1] != '=' {
+				return nil, fmt.Errorf("unexpected %q at position %d", c, i)
+			}
+			tokens = append(tokens, "!=")
+			i += 2
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(text[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated value at position %d", i)
+			}
+			tokens = append(tokens, text[i:i+end+2])
+			i += end + 2
+		default:
+			start := i
+			for i < len(text) && !strings.ContainsRune(" \t\n\r{},=!'\"", rune(text[i])) {
+				i++
+			}
+			tokens = append(tokens, text[start:i])
+		}
+	}
+	return tokens, nil
+}
+
+type dynamicGroupRuleParser struct {
+	tokens   []string
+	position int
+}
+
+func (p *dynamicGroupRuleParser) next() string {
+	if p.position >= len(p.tokens) {
+		return ""
+	}
+	token := p.tokens[p.position]
+	p.position++
+	return token
+}
+
+func (p *dynamicGroupRuleParser) peek() string {
+	if p.position >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.position]
+}
+
+func (p *dynamicGroupRuleParser) parseRule() (*dynamicGroupRule, error) {
+	token := p.next()
+	match := strings.ToLower(token)
+	if (match == dynamicGroupRuleAll || match == dynamicGroupRuleAny) && p.peek() == "{" {
+		p.next()
+		rule := &dynamicGroupRule{Match: match}
+		for {
+			child, err := p.parseRule()
+			if err != nil {
+				return nil, err
+			}
+			rule.Rules = append(rule.Rules, child)
+
+			switch separator := p.next(); separator {
+			case ",":
+				continue
+			case "}":
+				return rule, nil
+			case "":
+				return nil, fmt.Errorf("missing closing brace")
+			default:
+				return nil, fmt.Errorf("unexpected %q, expected \",\" or \"}\"", separator)
+			}
+		}
+	}
+
+	if token == "" || strings.ContainsAny(token, "{},=!'\"") {
+		return nil, fmt.Errorf("unexpected %q, expected a condition", token)
+	}
+	rule := &dynamicGroupRule{Attribute: strings.ToLower(token)}
+	if operator := p.peek(); operator == "=" || operator == "!=" {
+		p.next()
+		value := p.next()
+		if value == "" || strings.ContainsAny(value[:1], "{},=!") {
+			return nil, fmt.Errorf("missing value of %s", token)
+		}
+		rule.Operator = operator
+		rule.Value = strings.Trim(value, "'\"")
+	}
+	return rule, nil
+}
+
+// matches returns whether a resource matches the rule. Conditions on unknown attributes never match.
+func (r *dynamicGroupRule) matches(resource *dynamicGroupResource) bool {
+	switch r.Match {
+	case dynamicGroupRuleAll:
+		for _, rule := range r.Rules {
+			if !rule.matches(resource) {
+				return false
+			}
+		}
+		return true
+	case dynamicGroupRuleAny:
+		for _, rule := range r.Rules {
+			if rule.matches(resource) {
+				return true
+			}
+		}
+		return false
+	}
+
+	value, ok := resource.attribute(r.Attribute)
+	switch r.Operator {
+	case "=":
+		return ok && value == r.Value
+	case "!=":
+		return ok && value != r.Value
+	}
+	return ok
+}
+
+// attribute returns the value of an attribute of the resource. Instances are matched by the instance.*
+// attributes, other resources by the resource.* attributes, and both by their defined tags.
+func (r *dynamicGroupResource) attribute(name string) (string, bool) {
+	isInstance := r.Type == "instance"
+	switch name {
+	case "instance.id":
+		return r.Id, isInstance
+	case "instance.compartment.id":
+		return r.CompartmentId, isInstance
+	case "resource.id":
+		return r.Id, !isInstance
+	case "resource.compartment.id":
+		return r.CompartmentId, !isInstance
+	case "resource.type":
+		return r.Type, !isInstance
+	}
+
+	// tag.<namespace>.<key>.value, tag namespaces and keys are case insensitive
+	parts := strings.Split(name, ".")
+	if len(parts) != 4 || parts[0] != "tag" || parts[3] != "value" {
+		return "", false
+	}
+	for namespace, tags := range r.DefinedTags {
+		if !strings.EqualFold(namespace, parts[1]) {
+			continue
+		}
+		for key, value := range tags {
+			if strings.EqualFold(key, parts[2]) {
+				return fmt.Sprint(value), true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,383 @@
+package oci
+
+import "slices"
+
+// Permissions added by each verb on the resource types of the common resource families. The permissions of a
+// verb include the permissions of the verbs it implies, e.g. read instances grants INSTANCE_INSPECT and INSTANCE_READ.
+// https://docs.oracle.com/en-us/iaas/Content/Identity/Reference/policyreference.htm
+var policyVerbPermissions = map[string]map[string][]string{
+	// cluster-family
+	"clusters": {
+		"inspect": {"CLUSTER_INSPECT"},
+		"read":    {"CLUSTER_READ"},
+		"use":     {"CLUSTER_USE"},
+		"manage":  {"CLUSTER_CREATE", "CLUSTER_DELETE", "CLUSTER_UPDATE", "CLUSTER_MANAGE", "CLUSTER_MOVE"},
+	},
+	"cluster-node-pools": {
+		"inspect": {"CLUSTER_NODE_POOL_INSPECT"},
+		"read":    {"CLUSTER_NODE_POOL_READ"},
+		"manage":  {"CLUSTER_NODE_POOL_CREATE", "CLUSTER_NODE_POOL_DELETE", "CLUSTER_NODE_POOL_UPDATE"},
+	},
+	"cluster-virtualnode-pools": {
+		"inspect": {"CLUSTER_VIRTUAL_NODE_POOL_INSPECT"},
+		"read":    {"CLUSTER_VIRTUAL_NODE_POOL_READ"},
+		"manage":  {"CLUSTER_VIRTUAL_NODE_POOL_CREATE", "CLUSTER_VIRTUAL_NODE_POOL_DELETE", "CLUSTER_VIRTUAL_NODE_POOL_UPDATE"},
+	},
+	"cluster-work-requests": {
+		"inspect": {"CLUSTER_WORK_REQUEST_INSPECT"},
+		"read":    {"CLUSTER_WORK_REQUEST_READ"},
+		"manage":  {"CLUSTER_WORK_REQUEST_DELETE"},
+	},
+
+	// database-family
+	"db-systems": {
+		"inspect": {"DB_SYSTEM_INSPECT"},
+		"read":    {"DB_SYSTEM_READ"},
+		"use":     {"DB_SYSTEM_UPDATE"},
+		"manage":  {"DB_SYSTEM_CREATE", "DB_SYSTEM_DELETE", "DB_SYSTEM_MOVE"},
+	},
+	"db-nodes": {
+		"inspect": {"DB_NODE_INSPECT"},
+		"read":    {"DB_NODE_QUERY"},
+		"use":     {"DB_NODE_POWER_ACTIONS"},
+	},
+	"db-homes": {
+		"inspect": {"DB_HOME_INSPECT"},
+		"use":     {"DB_HOME_UPDATE"},
+		"manage":  {"DB_HOME_CREATE", "DB_HOME_DELETE"},
+	},
+	"databases": {
+		"inspect": {"DATABASE_INSPECT"},
+		"use":     {"DATABASE_UPDATE"},
+		"manage":  {"DATABASE_CREATE", "DATABASE_DELETE", "DATABASE_MOVE"},
+	},
+	"pluggable-databases": {
+		"inspect": {"PLUGGABLE_DATABASE_INSPECT"},
+		"read":    {"PLUGGABLE_DATABASE_READ"},
+		"use":     {"PLUGGABLE_DATABASE_UPDATE"},
+		"manage":  {"PLUGGABLE_DATABASE_CREATE", "PLUGGABLE_DATABASE_DELETE"},
+	},
+	"db-backups": {
+		"inspect": {"DB_BACKUP_INSPECT"},
+		"read":    {"DB_BACKUP_CONTENT_READ"},
+		"manage":  {"DB_BACKUP_CREATE", "DB_BACKUP_DELETE"},
+	},
+	"vmclusters": {
+		"inspect": {"VM_CLUSTER_INSPECT"},
+		"read":    {"VM_CLUSTER_READ"},
+		"use":     {"VM_CLUSTER_UPDATE"},
+		"manage":  {"VM_CLUSTER_CREATE", "VM_CLUSTER_DELETE"},
+	},
+	"backup-destinations": {
+		"inspect": {"BACKUP_DESTINATION_INSPECT"},
+		"read":    {"BACKUP_DESTINATION_READ"},
+		"use":     {"BACKUP_DESTINATION_UPDATE"},
+		"manage":  {"BACKUP_DESTINATION_CREATE", "BACKUP_DESTINATION_DELETE"},
+	},
+
+	// dns
+	"dns-zones": {
+		"inspect": {"DNS_ZONE_INSPECT"},
+		"read":    {"DNS_ZONE_READ"},
+		"use":     {"DNS_ZONE_UPDATE"},
+		"manage":  {"DNS_ZONE_CREATE", "DNS_ZONE_DELETE", "DNS_ZONE_MOVE"},
+	},
+	"dns-records": {
+		"inspect": {"DNS_RECORD_INSPECT"},
+		"read":    {"DNS_RECORD_READ"},
+		"use":     {"DNS_RECORD_UPDATE"},
+	},
+	"dns-traffic": {
+		"read": {"DNS_TRAFFIC_READ"},
+	},
+	"dns-steering-policies": {
+		"inspect": {"DNS_STEERING_POLICY_INSPECT"},
+		"read":    {"DNS_STEERING_POLICY_READ"},
+		"use":     {"DNS_STEERING_POLICY_UPDATE"},
+		"manage":  {"DNS_STEERING_POLICY_CREATE", "DNS_STEERING_POLICY_DELETE", "DNS_STEERING_POLICY_MOVE"},
+	},
+	"dns-steering-policy-attachments": {
+		"inspect": {"DNS_STEERING_ATTACHMENT_INSPECT"},
+		"read":    {"DNS_STEERING_ATTACHMENT_READ"},
+		"use":     {"DNS_STEERING_ATTACHMENT_UPDATE"},
+		"manage":  {"DNS_STEERING_ATTACHMENT_CREATE", "DNS_STEERING_ATTACHMENT_DELETE"},
+	},
+	"dns-resolvers": {
+		"inspect": {"DNS_RESOLVER_INSPECT"},
+		"read":    {"DNS_RESOLVER_READ"},
+		"use":     {"DNS_RESOLVER_UPDATE"},
+		"manage":  {"DNS_RESOLVER_MOVE"},
+	},
+	"dns-views": {
+		"inspect": {"DNS_VIEW_INSPECT"},
+		"read":    {"DNS_VIEW_READ"},
+		"use":     {"DNS_VIEW_UPDATE"},
+		"manage":  {"DNS_VIEW_CREATE", "DNS_VIEW_DELETE", "DNS_VIEW_MOVE"},
+	},
+	"dns-tsig-keys": {
+		"inspect": {"DNS_TSIG_KEY_INSPECT"},
+		"read":    {"DNS_TSIG_KEY_READ"},
+		"use":     {"DNS_TSIG_KEY_UPDATE"},
+		"manage":  {"DNS_TSIG_KEY_CREATE", "DNS_TSIG_KEY_DELETE", "DNS_TSIG_KEY_MOVE"},
+	},
+
+	// file-family
+	"file-systems": {
+		"inspect": {"FILE_SYSTEM_INSPECT"},
+		"read":    {"FILE_SYSTEM_READ"},
+		"use":     {"FILE_SYSTEM_UPDATE", "FILE_SYSTEM_NFSv3_EXPORT", "FILE_SYSTEM_NFSv3_UNEXPORT"},
+		"manage":  {"FILE_SYSTEM_CREATE", "FILE_SYSTEM_DELETE", "FILE_SYSTEM_CREATE_SNAPSHOT", "FILE_SYSTEM_DELETE_SNAPSHOT", "FILE_SYSTEM_MOVE"},
+	},
+	"mount-targets": {
+		"inspect": {"MOUNT_TARGET_INSPECT"},
+		"read":    {"MOUNT_TARGET_READ"},
+		"use":     {"MOUNT_TARGET_UPDATE"},
+		"manage":  {"MOUNT_TARGET_CREATE", "MOUNT_TARGET_DELETE", "MOUNT_TARGET_MOVE"},
+	},
+	"export-sets": {
+		"inspect": {"EXPORT_SET_INSPECT"},
+		"read":    {"EXPORT_SET_READ"},
+		"use":     {"EXPORT_SET_UPDATE"},
+	},
+
+	// instance-family
+	"instances": {
+		"inspect": {"INSTANCE_INSPECT"},
+		"read":    {"INSTANCE_READ"},
+		"use":     {"INSTANCE_UPDATE", "INSTANCE_CREATE_IMAGE", "INSTANCE_POWER_ACTIONS", "INSTANCE_ATTACH_VOLUME", "INSTANCE_DETACH_VOLUME"},
+		"manage":  {"INSTANCE_CREATE", "INSTANCE_DELETE", "INSTANCE_ATTACH_SECONDARY_VNIC", "INSTANCE_DETACH_SECONDARY_VNIC", "INSTANCE_MOVE"},
+	},
+	"instance-console-connection": {
+		"inspect": {"INSTANCE_CONSOLE_CONNECTION_INSPECT"},
+		"read":    {"INSTANCE_CONSOLE_CONNECTION_READ"},
+		"manage":  {"INSTANCE_CONSOLE_CONNECTION_CREATE", "INSTANCE_CONSOLE_CONNECTION_DELETE"},
+	},
+	"instance-images": {
+		"inspect": {"INSTANCE_IMAGE_INSPECT"},
+		"read":    {"INSTANCE_IMAGE_READ"},
+		"use":     {"INSTANCE_IMAGE_UPDATE"},
+		"manage":  {"INSTANCE_IMAGE_CREATE", "INSTANCE_IMAGE_DELETE", "INSTANCE_IMAGE_MOVE"},
+	},
+	"volume-attachments": {
+		"inspect": {"VOLUME_ATTACHMENT_INSPECT"},
+		"read":    {"VOLUME_ATTACHMENT_READ"},
+		"use":     {"VOLUME_ATTACHMENT_UPDATE"},
+		"manage":  {"VOLUME_ATTACHMENT_CREATE", "VOLUME_ATTACHMENT_DELETE"},
+	},
+	"console-histories": {
+		"inspect": {"CONSOLE_HISTORY_INSPECT"},
+		"read":    {"CONSOLE_HISTORY_READ"},
+		"manage":  {"CONSOLE_HISTORY_CREATE", "CONSOLE_HISTORY_DELETE"},
+	},
+	"app-catalog-listing": {
+		"inspect": {"APP_CATALOG_LISTING_INSPECT"},
+		"read":    {"APP_CATALOG_LISTING_READ"},
+		"manage":  {"APP_CATALOG_LISTING_SUBSCRIBE"},
+	},
+
+	// object-family
+	"buckets": {
+		"inspect": {"BUCKET_INSPECT"},
+		"read":    {"BUCKET_READ"},
+		"use":     {"BUCKET_UPDATE"},
+		"manage":  {"BUCKET_CREATE", "BUCKET_DELETE", "PAR_MANAGE", "RETENTION_RULE_MANAGE", "RETENTION_RULE_LOCK"},
+	},
+	"objects": {
+		"inspect": {"OBJECT_INSPECT"},
+		"read":    {"OBJECT_READ"},
+		"use":     {"OBJECT_OVERWRITE"},
+		"manage":  {"OBJECT_CREATE", "OBJECT_DELETE", "OBJECT_RESTORE", "OBJECT_VERSION_DELETE"},
+	},
+
+	// secret-family
+	"secrets": {
+		"inspect": {"SECRET_INSPECT"},
+		"read":    {"SECRET_READ"},
+		"manage":  {"SECRET_CREATE", "SECRET_UPDATE", "SECRET_ROTATE", "SECRET_DELETE", "SECRET_MOVE"},
+	},
+	"secret-bundles": {
+		"read": {"SECRET_BUNDLE_READ"},
+	},
+
+	// virtual-network-family
+	"vcns": {
+		"inspect": {"VCN_READ"},
+		"use":     {"VCN_ATTACH", "VCN_DETACH"},
+		"manage":  {"VCN_CREATE", "VCN_DELETE", "VCN_UPDATE", "VCN_MOVE"},
+	},
+	"subnets": {
+		"inspect": {"SUBNET_READ"},
+		"use":     {"SUBNET_ATTACH", "SUBNET_DETACH"},
+		"manage":  {"SUBNET_CREATE", "SUBNET_DELETE", "SUBNET_UPDATE", "SUBNET_MOVE"},
+	},
+	"route-tables": {
+		"inspect": {"ROUTE_TABLE_READ"},
+		"use":     {"ROUTE_TABLE_ATTACH", "ROUTE_TABLE_DETACH"},
+		"manage":  {"ROUTE_TABLE_CREATE", "ROUTE_TABLE_DELETE", "ROUTE_TABLE_UPDATE", "ROUTE_TABLE_MOVE"},
+	},
+	"network-security-groups": {
+		"inspect": {"NETWORK_SECURITY_GROUP_INSPECT"},
+		"read":    {"NETWORK_SECURITY_GROUP_LIST_SECURITY_RULES", "NETWORK_SECURITY_GROUP_LIST_MEMBERS"},
+		"use":     {"NETWORK_SECURITY_GROUP_UPDATE_MEMBERS"},
+		"manage":  {"NETWORK_SECURITY_GROUP_CREATE", "NETWORK_SECURITY_GROUP_DELETE", "NETWORK_SECURITY_GROUP_UPDATE", "NETWORK_SECURITY_GROUP_UPDATE_SECURITY_RULES", "NETWORK_SECURITY_GROUP_MOVE"},
+	},
+	"security-lists": {
+		"inspect": {"SECURITY_LIST_READ"},
+		"use":     {"SECURITY_LIST_ATTACH", "SECURITY_LIST_DETACH"},
+		"manage":  {"SECURITY_LIST_CREATE", "SECURITY_LIST_DELETE", "SECURITY_LIST_UPDATE", "SECURITY_LIST_MOVE"},
+	},
+	"dhcp-options": {
+		"inspect": {"DHCP_READ"},
+		"use":     {"DHCP_ATTACH", "DHCP_DETACH"},
+		"manage":  {"DHCP_CREATE", "DHCP_DELETE", "DHCP_UPDATE", "DHCP_MOVE"},
+	},
+	"private-ips": {
+		"inspect": {"PRIVATE_IP_READ"},
+		"use":     {"PRIVATE_IP_CREATE", "PRIVATE_IP_DELETE", "PRIVATE_IP_UPDATE", "PRIVATE_IP_ASSIGN", "PRIVATE_IP_UNASSIGN", "PRIVATE_IP_ASSIGN_PUBLIC_IP", "PRIVATE_IP_UNASSIGN_PUBLIC_IP"},
+	},
+	"public-ips": {
+		"inspect": {"PUBLIC_IP_READ"},
+		"use":     {"PUBLIC_IP_ASSIGN_PRIVATE_IP", "PUBLIC_IP_UNASSIGN_PRIVATE_IP"},
+		"manage":  {"PUBLIC_IP_CREATE", "PUBLIC_IP_DELETE", "PUBLIC_IP_UPDATE", "PUBLIC_IP_MOVE"},
+	},
+	"ipv6s": {
+		"inspect": {"IPV6_READ"},
+		"use":     {"IPV6_CREATE", "IPV6_DELETE", "IPV6_UPDATE"},
+		"manage":  {"IPV6_MOVE"},
+	},
+	"internet-gateways": {
+		"inspect": {"INTERNET_GATEWAY_READ"},
+		"use":     {"INTERNET_GATEWAY_ATTACH", "INTERNET_GATEWAY_DETACH"},
+		"manage":  {"INTERNET_GATEWAY_CREATE", "INTERNET_GATEWAY_DELETE", "INTERNET_GATEWAY_UPDATE", "INTERNET_GATEWAY_MOVE"},
+	},
+	"nat-gateways": {
+		"inspect": {"NAT_GATEWAY_READ"},
+		"use":     {"NAT_GATEWAY_ATTACH", "NAT_GATEWAY_DETACH"},
+		"manage":  {"NAT_GATEWAY_CREATE", "NAT_GATEWAY_DELETE", "NAT_GATEWAY_UPDATE", "NAT_GATEWAY_MOVE"},
+	},
+	"service-gateways": {
+		"inspect": {"SERVICE_GATEWAY_READ"},
+		"use":     {"SERVICE_GATEWAY_ATTACH", "SERVICE_GATEWAY_DETACH"},
+		"manage":  {"SERVICE_GATEWAY_CREATE", "SERVICE_GATEWAY_DELETE", "SERVICE_GATEWAY_UPDATE", "SERVICE_GATEWAY_ADD_SERVICE", "SERVICE_GATEWAY_DELETE_SERVICE", "SERVICE_GATEWAY_MOVE"},
+	},
+	"local-peering-gateways": {
+		"inspect": {"LOCAL_PEERING_GATEWAY_READ"},
+		"use":     {"LOCAL_PEERING_GATEWAY_ATTACH", "LOCAL_PEERING_GATEWAY_DETACH"},
+		"manage":  {"LOCAL_PEERING_GATEWAY_CREATE", "LOCAL_PEERING_GATEWAY_DELETE", "LOCAL_PEERING_GATEWAY_UPDATE", "LOCAL_PEERING_GATEWAY_CONNECT_FROM", "LOCAL_PEERING_GATEWAY_CONNECT_TO", "LOCAL_PEERING_GATEWAY_MOVE"},
+	},
+	"remote-peering-connections": {
+		"inspect": {"REMOTE_PEERING_CONNECTION_READ"},
+		"manage":  {"REMOTE_PEERING_CONNECTION_CREATE", "REMOTE_PEERING_CONNECTION_DELETE", "REMOTE_PEERING_CONNECTION_UPDATE", "REMOTE_PEERING_CONNECTION_CONNECT_FROM", "REMOTE_PEERING_CONNECTION_CONNECT_TO", "REMOTE_PEERING_CONNECTION_RESOURCE_MOVE"},
+	},
+	"drgs": {
+		"inspect": {"DRG_READ"},
+		"use":     {"DRG_ATTACH", "DRG_DETACH"},
+		"manage":  {"DRG_CREATE", "DRG_DELETE", "DRG_UPDATE", "DRG_MOVE"},
+	},
+	"drg-attachments": {
+		"inspect": {"DRG_ATTACHMENT_READ"},
+		"manage":  {"DRG_ATTACHMENT_UPDATE"},
+	},
+	"cpes": {
+		"inspect": {"CPE_READ"},
+		"manage":  {"CPE_CREATE", "CPE_DELETE", "CPE_UPDATE", "CPE_RESOURCE_MOVE"},
+	},
+	"ipsec-connections": {
+		"inspect": {"IPSEC_CONNECTION_READ"},
+		"use":     {"IPSEC_CONNECTION_DEVICE_CONFIG_READ"},
+		"manage":  {"IPSEC_CONNECTION_CREATE", "IPSEC_CONNECTION_DELETE", "IPSEC_CONNECTION_UPDATE", "IPSEC_CONNECTION_DEVICE_CONFIG_UPDATE"},
+	},
+	"cross-connects": {
+		"inspect": {"CROSS_CONNECT_READ"},
+		"use":     {"CROSS_CONNECT_ATTACH", "CROSS_CONNECT_DETACH"},
+		"manage":  {"CROSS_CONNECT_CREATE", "CROSS_CONNECT_DELETE", "CROSS_CONNECT_UPDATE", "CROSS_CONNECT_RESOURCE_MOVE"},
+	},
+	"cross-connect-groups": {
+		"inspect": {"CROSS_CONNECT_GROUP_READ"},
+		"manage":  {"CROSS_CONNECT_GROUP_CREATE", "CROSS_CONNECT_GROUP_DELETE", "CROSS_CONNECT_GROUP_UPDATE", "CROSS_CONNECT_GROUP_RESOURCE_MOVE"},
+	},
+	"virtual-circuits": {
+		"inspect": {"VIRTUAL_CIRCUIT_READ"},
+		"use":     {"VIRTUAL_CIRCUIT_UPDATE"},
+		"manage":  {"VIRTUAL_CIRCUIT_CREATE", "VIRTUAL_CIRCUIT_DELETE", "VIRTUAL_CIRCUIT_RESOURCE_MOVE"},
+	},
+	"vnics": {
+		"inspect": {"VNIC_READ"},
+		"use":     {"VNIC_ATTACH", "VNIC_DETACH", "VNIC_CREATE", "VNIC_DELETE", "VNIC_UPDATE"},
+	},
+	"vnic-attachments": {
+		"inspect": {"VNIC_ATTACHMENT_READ"},
+	},
+	"vlans": {
+		"inspect": {"VLAN_READ"},
+		"use":     {"VLAN_ATTACH", "VLAN_DETACH"},
+		"manage":  {"VLAN_CREATE", "VLAN_DELETE", "VLAN_UPDATE", "VLAN_MOVE"},
+	},
+
+	// volume-family
+	"volumes": {
+		"inspect": {"VOLUME_INSPECT"},
+		"use":     {"VOLUME_UPDATE", "VOLUME_WRITE"},
+		"manage":  {"VOLUME_CREATE", "VOLUME_DELETE", "VOLUME_MOVE"},
+	},
+	"volume-backups": {
+		"inspect": {"VOLUME_BACKUP_INSPECT"},
+		"read":    {"VOLUME_BACKUP_READ"},
+		"use":     {"VOLUME_BACKUP_COPY", "VOLUME_BACKUP_UPDATE"},
+		"manage":  {"VOLUME_BACKUP_CREATE", "VOLUME_BACKUP_DELETE", "VOLUME_BACKUP_MOVE"},
+	},
+	"boot-volume-backups": {
+		"inspect": {"BOOT_VOLUME_BACKUP_INSPECT"},
+		"read":    {"BOOT_VOLUME_BACKUP_READ"},
+		"use":     {"BOOT_VOLUME_BACKUP_COPY", "BOOT_VOLUME_BACKUP_UPDATE"},
+		"manage":  {"BOOT_VOLUME_BACKUP_CREATE", "BOOT_VOLUME_BACKUP_DELETE", "BOOT_VOLUME_BACKUP_MOVE"},
+	},
+	"backup-policies": {
+		"inspect": {"BACKUP_POLICIES_INSPECT"},
+		"manage":  {"BACKUP_POLICIES_CREATE", "BACKUP_POLICIES_DELETE", "BACKUP_POLICIES_UPDATE"},
+	},
+	"backup-policy-assignments": {
+		"inspect": {"BACKUP_POLICY_ASSIGNMENT_INSPECT"},
+		"manage":  {"BACKUP_POLICY_ASSIGNMENT_CREATE", "BACKUP_POLICY_ASSIGNMENT_DELETE"},
+	},
+	"volume-groups": {
+		"inspect": {"VOLUME_GROUP_INSPECT"},
+		"use":     {"VOLUME_GROUP_UPDATE"},
+		"manage":  {"VOLUME_GROUP_CREATE", "VOLUME_GROUP_DELETE", "VOLUME_GROUP_MOVE"},
+	},
+	"volume-group-backups": {
+		"inspect": {"VOLUME_GROUP_BACKUP_INSPECT"},
+		"use":     {"VOLUME_GROUP_BACKUP_UPDATE", "VOLUME_GROUP_BACKUP_COPY"},
+		"manage":  {"VOLUME_GROUP_BACKUP_CREATE", "VOLUME_GROUP_BACKUP_DELETE", "VOLUME_GROUP_BACKUP_MOVE"},
+	},
+}
+
+// ImpliedPermissions returns the permissions granted by the statement, i.e. the listed permissions, or the
+// permissions of the implied verbs on the resource types of the statement. Resource types missing from
+// policyVerbPermissions grant no permissions, and all-resources grants the permissions of every known type.
+func (s *identityPolicyStatement) ImpliedPermissions() []string {
+	if len(s.Permissions) > 0 {
+		return s.Permissions
+	}
+
+	resourceTypes := s.ResourceTypes()
+	if s.Resource == "all-resources" {
+		resourceTypes = make([]string, 0, len(policyVerbPermissions))
+		for resourceType := range policyVerbPermissions {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		slices.Sort(resourceTypes)
+	}
+
+	var permissions []string
+	for _, resourceType := range resourceTypes {
+		for _, verb := range s.ImpliedVerbs() {
+			for _, permission := range policyVerbPermissions[resourceType][verb] {
+				if !slices.Contains(permissions, permission) {
+					permissions = append(permissions, permission)
+				}
+			}
+		}
+	}
+	return permissions
+}
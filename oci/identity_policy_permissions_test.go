@@ -0,0 +1,71 @@
+package oci
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPolicyVerbPermissionsCoverResourceFamilies(t *testing.T) {
+	for family, resourceTypes := range policyResourceFamilies {
+		for _, resourceType := range resourceTypes {
+			if len(policyVerbPermissions[resourceType]) == 0 {
+				t.Errorf("resource type %q of %s has no permissions", resourceType, family)
+			}
+		}
+	}
+}
+
+func TestImpliedPermissions(t *testing.T) {
+	cases := []struct {
+		name      string
+		statement identityPolicyStatement
+		contains  []string
+		excludes  []string
+	}{
+		{
+			name:      "read includes inspect",
+			statement: identityPolicyStatement{Verb: "read", Resource: "instances"},
+			contains:  []string{"INSTANCE_INSPECT", "INSTANCE_READ"},
+			excludes:  []string{"INSTANCE_UPDATE", "INSTANCE_CREATE"},
+		},
+		{
+			name:      "resource family",
+			statement: identityPolicyStatement{Verb: "manage", Resource: "object-family"},
+			contains:  []string{"BUCKET_INSPECT", "BUCKET_CREATE", "OBJECT_READ", "OBJECT_DELETE"},
+		},
+		{
+			name:      "all resources",
+			statement: identityPolicyStatement{Verb: "inspect", Resource: "all-resources"},
+			contains:  []string{"INSTANCE_INSPECT", "VCN_READ", "SECRET_INSPECT"},
+			excludes:  []string{"SECRET_READ"},
+		},
+		{
+			name:      "listed permissions",
+			statement: identityPolicyStatement{Permissions: []string{"USER_INSPECT", "USER_READ"}},
+			contains:  []string{"USER_INSPECT", "USER_READ"},
+		},
+		{
+			name:      "unknown resource type",
+			statement: identityPolicyStatement{Verb: "manage", Resource: "unknown-resources"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			permissions := c.statement.ImpliedPermissions()
+			for _, permission := range c.contains {
+				if !slices.Contains(permissions, permission) {
+					t.Errorf("expected %s in %v", permission, permissions)
+				}
+			}
+			for _, permission := range c.excludes {
+				if slices.Contains(permissions, permission) {
+					t.Errorf("unexpected %s in %v", permission, permissions)
+				}
+			}
+			if len(c.contains) == 0 && len(permissions) > 0 {
+				t.Errorf("expected no permissions, got %v", permissions)
+			}
+		})
+	}
+}
@@ -0,0 +1,471 @@
+package oci
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/turbot/go-kit/types"
+)
+
+// Parser of the statements of the IAM policy language, e.g.
+//
+//	Allow group 'Default'/'NetworkAdmins' to manage virtual-network-family in compartment Networks:Prod where request.operation != 'DeleteVcn'
+//	Define tenancy Acme as ocid1.tenancy.oc1..aaaa
+//	Endorse group Admins to manage object-family in tenancy Acme
+//	Admit group Auditors of tenancy Acme to read all-resources in tenancy
+//
+// https://docs.oracle.com/en-us/iaas/Content/Identity/Concepts/policysyntax.htm
+
+const (
+	policyStatementAllow   = "allow"
+	policyStatementDeny    = "deny"
+	policyStatementDefine  = "define"
+	policyStatementEndorse = "endorse"
+	policyStatementAdmit   = "admit"
+)
+
+var policyVerbs = []string{"inspect", "read", "use", "manage"}
+
+// identityPolicySubject is a group, dynamic group, service or any-user subject of a policy statement
+type identityPolicySubject struct {
+	Type   string `json:"type"`
+	Domain string `json:"domain,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Id     string `json:"id,omitempty"`
+}
+
+// identityPolicyStatement is a statement of a policy parsed into its parts
+type identityPolicyStatement struct {
+	PolicyId       *string
+	PolicyName     *string
+	CompartmentId  *string
+	StatementIndex int
+	Statement      string
+	StatementType  string
+	SubjectType    string
+	Subjects       []identityPolicySubject
+	Verb           string
+	Permissions    []string
+	Resource       string
+	LocationType   string
+	Location       string
+	LocationId     string
+	Conditions     string
+	TenancyAlias   string
+	ParseError     string
+}
+
+func (s *identityPolicyStatement) SubjectNames() []string {
+	var names []string
+	for _, subject := range s.Subjects {
+		if subject.Name != "" {
+			names = append(names, subject.Name)
+		}
+	}
+	return names
+}
+
+func (s *identityPolicyStatement) SubjectIds() []string {
+	var ids []string
+	for _, subject := range s.Subjects {
+		if subject.Id != "" {
+			ids = append(ids, subject.Id)
+		}
+	}
+	return ids
+}
+
+// policyToken is a word of a statement, with its position in the statement
+type policyToken struct {
+	Value string
+	End   int
+}
+
+// is returns true if the token is the given unquoted keyword
+func (t policyToken) is(keyword string) bool {
+	return strings.EqualFold(t.Value, keyword)
+}
+
+type policyStatementParser struct {
+	statement string
+	tokens    []policyToken
+	position  int
+}
+
+// parsePolicyStatement parses a statement of a policy. Statements which cannot be parsed are returned with a
+// parse error, and the parts parsed so far.
+func parsePolicyStatement(statement string) *identityPolicyStatement {
+	result := &identityPolicyStatement{Statement: statement}
+
+	tokens, err := tokenizePolicyStatement(statement)
+	if err != nil {
+		result.ParseError = err.Error()
+		return result
+	}
+	p := &policyStatementParser{statement: statement, tokens: tokens}
+	if err := p.parse(result); err != nil {
+		result.ParseError = err.Error()
+	}
+	return result
+}
+
+// tokenizePolicyStatement splits a statement into words, commas and permission lists. Quoted names, e.g.
+// 'My Group' or 'Default'/'Admins', are kept in a single word.
+func tokenizePolicyStatement(statement string) ([]policyToken, error) {
+	var tokens []policyToken
+	i := 0
+	for i < len(statement) {
+		c := statement[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == ',':
+			tokens = append(tokens, policyToken{Value: ",", End: i + 1})
+			i++
+		case c == '{':
+			end := strings.IndexByte(statement[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("missing closing brace in permission list")
+			}
+			tokens = append(tokens, policyToken{Value: statement[i : i+end+1], End: i + end + 1})
+			i += end + 1
+		default:
+			start := i
+			for i < len(statement) && !strings.ContainsRune(" \t\n\r,", rune(statement[i])) {
+				if statement[i] == '\'' || statement[i] == '"' {
+					end := strings.IndexByte(statement[i+1:], statement[i])
+					if end < 0 {
+						return nil, fmt.Errorf("missing closing quote")
+					}
+					i += end + 1
+				}
+				i++
+			}
+			tokens = append(tokens, policyToken{Value: statement[start:i], End: i})
+		}
+	}
+	return tokens, nil
+}
+
+func (p *policyStatementParser) peek() (policyToken, bool) {
+	if p.position >= len(p.tokens) {
+		return policyToken{}, false
+	}
+	return p.tokens[p.position], true
+}
+
+func (p *policyStatementParser) next(expected string) (policyToken, error) {
+	token, ok := p.peek()
+	if !ok {
+		return token, fmt.Errorf("unexpected end of statement, expected %s", expected)
+	}
+	p.position++
+	return token, nil
+}
+
+func (p *policyStatementParser) expect(keyword string) error {
+	token, err := p.next(keyword)
+	if err != nil {
+		return err
+	}
+	if !token.is(keyword) {
+		return fmt.Errorf("unexpected %q, expected %s", token.Value, keyword)
+	}
+	return nil
+}
+
+func (p *policyStatementParser) parse(result *identityPolicyStatement) error {
+	token, err := p.next("a statement keyword")
+	if err != nil {
+		return err
+	}
+	result.StatementType = strings.ToLower(token.Value)
+
+	switch result.StatementType {
+	case policyStatementDefine:
+		return p.parseDefine(result)
+	case policyStatementAllow, policyStatementDeny, policyStatementEndorse:
+		if err := p.parseSubjects(result, "to"); err != nil {
+			return err
+		}
+	case policyStatementAdmit:
+		if err := p.parseSubjects(result, "of"); err != nil {
+			return err
+		}
+		if err := p.expect("tenancy"); err != nil {
+			return err
+		}
+		alias, err := p.next("a tenancy alias")
+		if err != nil {
+			return err
+		}
+		result.TenancyAlias = unquotePolicyName(alias.Value)
+		if err := p.expect("to"); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown statement %q, expected allow, deny, define, endorse or admit", token.Value)
+	}
+
+	if err := p.parseVerbAndResource(result); err != nil {
+		return err
+	}
+	if err := p.parseLocation(result); err != nil {
+		return err
+	}
+
+	token, ok := p.peek()
+	if !ok {
+		return nil
+	}
+	if !token.is("where") {
+		return fmt.Errorf("unexpected %q after the location", token.Value)
+	}
+	result.Conditions = strings.TrimSpace(p.statement[token.End:])
+	if result.Conditions == "" {
+		return fmt.Errorf("missing conditions after where")
+	}
+	return nil
+}
+
+// parseDefine parses e.g. Define tenancy Acme as ocid1.tenancy.oc1..aaaa
+func (p *policyStatementParser) parseDefine(result *identityPolicyStatement) error {
+	aliasType, err := p.next("tenancy, group or dynamic-group")
+	if err != nil {
+		return err
+	}
+	alias, err := p.next("an alias")
+	if err != nil {
+		return err
+	}
+	if err := p.expect("as"); err != nil {
+		return err
+	}
+	id, err := p.next("an OCID")
+	if err != nil {
+		return err
+	}
+
+	result.SubjectType = strings.ToLower(aliasType.Value)
+	result.Subjects = []identityPolicySubject{{
+		Type: result.SubjectType,
+		Name: unquotePolicyName(alias.Value),
+		Id:   unquotePolicyName(id.Value),
+	}}
+	if result.SubjectType == "tenancy" {
+		result.TenancyAlias = unquotePolicyName(alias.Value)
+	}
+
+	if token, ok := p.peek(); ok {
+		return fmt.Errorf("unexpected %q after the OCID", token.Value)
+	}
+	return nil
+}
+
+// parseSubjects parses the comma separated subjects up to the given keyword, e.g.
+// group Admins, group id ocid1.group.oc1..aaaa, dynamic-group 'Default'/'Instances', any-user
+func (p *policyStatementParser) parseSubjects(result *identityPolicyStatement, until string) error {
+	subjectType := ""
+	for {
+		token, err := p.next(until)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case token.is(until):
+			if len(result.Subjects) == 0 {
+				return fmt.Errorf("missing subject")
+			}
+			result.SubjectType = result.Subjects[0].Type
+			return nil
+		case token.Value == ",":
+			continue
+		case token.is("any-user"), token.is("any-group"):
+			result.Subjects = append(result.Subjects, identityPolicySubject{Type: strings.ToLower(token.Value)})
+		case token.is("group"), token.is("dynamic-group"), token.is("service"):
+			subjectType = strings.ToLower(token.Value)
+		case subjectType == "":
+			return fmt.Errorf("unexpected %q, expected a subject type", token.Value)
+		case token.is("id"):
+			id, err := p.next("an OCID")
+			if err != nil {
+				return err
+			}
+			result.Subjects = append(result.Subjects, identityPolicySubject{Type: subjectType, Id: unquotePolicyName(id.Value)})
+		default:
+			result.Subjects = append(result.Subjects, parsePolicySubject(subjectType, token.Value))
+		}
+	}
+}
+
+// parsePolicySubject parses the name of a subject, which may be prefixed by an identity domain
+func parsePolicySubject(subjectType string, value string) identityPolicySubject {
+	subject := identityPolicySubject{Type: subjectType}
+	if strings.HasPrefix(value, "ocid1.") {
+		subject.Id = value
+		return subject
+	}
+	if domain, name, ok := splitPolicyName(value); ok {
+		subject.Domain = domain
+		subject.Name = name
+		return subject
+	}
+	subject.Name = unquotePolicyName(value)
+	return subject
+}
+
+// parseVerbAndResource parses e.g. manage all-resources, or a permission list such as {USER_INSPECT, USER_READ}
+func (p *policyStatementParser) parseVerbAndResource(result *identityPolicyStatement) error {
+	token, err := p.next("a verb")
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(token.Value, "{") {
+		for _, permission := range strings.Split(strings.Trim(token.Value, "{}"), ",") {
+			if permission = strings.TrimSpace(permission); permission != "" {
+				result.Permissions = append(result.Permissions, strings.ToUpper(permission))
+			}
+		}
+		if len(result.Permissions) == 0 {
+			return fmt.Errorf("empty permission list")
+		}
+		return p.expect("in")
+	}
+
+	verb := strings.ToLower(token.Value)
+	if !slices.Contains(policyVerbs, verb) {
+		return fmt.Errorf("unknown verb %q, expected inspect, read, use or manage", token.Value)
+	}
+	result.Verb = verb
+
+	resource, err := p.next("a resource type")
+	if err != nil {
+		return err
+	}
+	result.Resource = strings.ToLower(resource.Value)
+
+	return p.expect("in")
+}
+
+// parseLocation parses e.g. tenancy, tenancy Acme, any-tenancy, compartment Networks:Prod or compartment id ocid1.compartment.oc1..aaaa
+func (p *policyStatementParser) parseLocation(result *identityPolicyStatement) error {
+	token, err := p.next("a location")
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case token.is("tenancy"):
+		result.LocationType = "tenancy"
+		// endorse statements name the other tenancy
+		if alias, ok := p.peek(); ok && !alias.is("where") {
+			p.position++
+			result.Location = unquotePolicyName(alias.Value)
+			result.TenancyAlias = result.Location
+		}
+	case token.is("any-tenancy"):
+		result.LocationType = "any-tenancy"
+	case token.is("compartment"):
+		result.LocationType = "compartment"
+		name, err := p.next("a compartment")
+		if err != nil {
+			return err
+		}
+		if name.is("id") {
+			id, err := p.next("a compartment OCID")
+			if err != nil {
+				return err
+			}
+			result.LocationId = unquotePolicyName(id.Value)
+		} else {
+			result.Location = unquotePolicyName(name.Value)
+		}
+	default:
+		return fmt.Errorf("unexpected %q, expected tenancy or compartment", token.Value)
+	}
+	return nil
+}
+
+// splitPolicyName splits a domain prefixed name, e.g. 'Default'/'Admins' or Default/Admins
+func splitPolicyName(value string) (string, string, bool) {
+	inQuote := byte(0)
+	for i := 0; i < len(value); i++ {
+		switch {
+		case inQuote != 0 && value[i] == inQuote:
+			inQuote = 0
+		case inQuote == 0 && (value[i] == '\'' || value[i] == '"'):
+			inQuote = value[i]
+		case inQuote == 0 && value[i] == '/':
+			return unquotePolicyName(value[:i]), unquotePolicyName(value[i+1:]), true
+		}
+	}
+	return "", "", false
+}
+
+func unquotePolicyName(value string) string {
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// Resource types of the common resource families, used to expand the resource of a statement.
+// https://docs.oracle.com/en-us/iaas/Content/Identity/Reference/policyreference.htm
+var policyResourceFamilies = map[string][]string{
+	"cluster-family":         {"clusters", "cluster-node-pools", "cluster-virtualnode-pools", "cluster-work-requests"},
+	"database-family":        {"db-systems", "db-nodes", "db-homes", "databases", "pluggable-databases", "db-backups", "vmclusters", "backup-destinations"},
+	"dns":                    {"dns-zones", "dns-records", "dns-traffic", "dns-steering-policies", "dns-steering-policy-attachments", "dns-resolvers", "dns-views", "dns-tsig-keys"},
+	"file-family":            {"file-systems", "mount-targets", "export-sets"},
+	"instance-family":        {"instances", "instance-console-connection", "instance-images", "volume-attachments", "console-histories", "app-catalog-listing"},
+	"object-family":          {"buckets", "objects"},
+	"secret-family":          {"secrets", "secret-bundles"},
+	"virtual-network-family": {"vcns", "subnets", "route-tables", "network-security-groups", "security-lists", "dhcp-options", "private-ips", "public-ips", "ipv6s", "internet-gateways", "nat-gateways", "service-gateways", "local-peering-gateways", "remote-peering-connections", "drgs", "drg-attachments", "cpes", "ipsec-connections", "cross-connects", "cross-connect-groups", "virtual-circuits", "vnics", "vnic-attachments", "vlans"},
+	"volume-family":          {"volumes", "volume-attachments", "volume-backups", "boot-volume-backups", "backup-policies", "backup-policy-assignments", "volume-groups", "volume-group-backups"},
+}
+
+// ImpliedVerbs returns the verb of the statement and the verbs it includes, e.g. read includes inspect
+func (s *identityPolicyStatement) ImpliedVerbs() []string {
+	index := slices.Index(policyVerbs, s.Verb)
+	if index < 0 {
+		return nil
+	}
+	return policyVerbs[:index+1]
+}
+
+// ResourceTypes returns the resource types of the resource family of the statement, or the resource type itself
+func (s *identityPolicyStatement) ResourceTypes() []string {
+	if s.Resource == "" {
+		return nil
+	}
+	if resourceTypes, ok := policyResourceFamilies[s.Resource]; ok {
+		return resourceTypes
+	}
+	return []string{s.Resource}
+}
+
+// resolvePolicyLocation returns the OCID of the compartment a statement applies to. Compartment names and
+// paths are relative to the compartment of the policy, e.g. Networks:Prod.
+func resolvePolicyLocation(tree *compartmentTree, statement *identityPolicyStatement) (string, bool) {
+	switch {
+	case statement.LocationType == "tenancy" && statement.TenancyAlias == "":
+		return tree.rootId, true
+	case statement.LocationType != "compartment":
+		return "", false
+	case statement.LocationId != "":
+		_, ok := tree.compartments[statement.LocationId]
+		return statement.LocationId, ok
+	}
+
+	current := types.SafeString(statement.CompartmentId)
+	for _, name := range strings.Split(statement.Location, ":") {
+		child, ok := tree.childByName(current, strings.TrimSpace(unquotePolicyName(name)))
+		if !ok {
+			return "", false
+		}
+		current = child
+	}
+	return current, true
+}
@@ -2,12 +2,17 @@ package oci
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/monitoring"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
@@ -65,6 +70,11 @@ func commonMonitoringMetricColumns() []*plugin.Column {
 			Description: "The time stamp used for the data point.",
 			Type:        proto.ColumnType_TIMESTAMP,
 		},
+		{
+			Name:        "period",
+			Description: "The aggregation interval of the data points, e.g. 5m, 1h or 1d. Defaults to the granularity of the table.",
+			Type:        proto.ColumnType_STRING,
+		},
 		{
 			Name:        "region",
 			Description: ColumnDescriptionRegion,
@@ -124,22 +134,123 @@ type MonitoringMetricRow struct {
 	// The standard unit for the data point.
 	Unit *string
 
+	// The aggregation interval of the data point.
+	Period *string
+
 	Metadata map[string]string
 
 	Region string
 }
 
-func getMonitoringStartDateForGranularity(granularity string) time.Time {
-	switch strings.ToUpper(granularity) {
-	case "DAILY":
+// key columns of the metric tables, which narrow or extend the time range of the data points and change
+// their aggregation interval
+func monitoringMetricKeyColumns() []*plugin.KeyColumn {
+	return []*plugin.KeyColumn{
+		{
+			Name:      "timestamp",
+			Operators: []string{">", ">=", "=", "<", "<="},
+			Require:   plugin.Optional,
+		},
+		{
+			Name:    "period",
+			Require: plugin.Optional,
+		},
+	}
+}
+
+// OCI Monitoring keeps the metric data points for 90 days
+const monitoringMetricRetention = 90 * 24 * time.Hour
+
+var monitoringPeriodRegex = regexp.MustCompile(`^([0-9]+)([mhd])$`)
+
+// monitoringMetricWindow is the time range and aggregation interval of the data points of a metric query
+type monitoringMetricWindow struct {
+	StartTime time.Time
+	EndTime   time.Time
+	Period    string
+}
+
+// getMonitoringMetricWindow returns the time range and interval of the metric query from the timestamp
+// and period quals. The period defaults to the granularity of the table, and the time range to the fixed
+// window of the period. The time range is clamped to the retention of the metric data points.
+func getMonitoringMetricWindow(d *plugin.QueryData, granularity string) (*monitoringMetricWindow, error) {
+	now := time.Now()
+	window := &monitoringMetricWindow{
+		EndTime: now,
+		Period:  getMonitoringPeriodForGranularity(granularity),
+	}
+
+	if period := d.EqualsQualString("period"); period != "" {
+		if _, err := getMonitoringPeriodDuration(period); err != nil {
+			return nil, err
+		}
+		window.Period = period
+	}
+	periodDuration, _ := getMonitoringPeriodDuration(window.Period)
+
+	var startTime *time.Time
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				startTime = &timestamp
+				window.EndTime = timestamp.Add(periodDuration)
+			case ">=", ">":
+				startTime = &timestamp
+			case "<", "<=":
+				window.EndTime = timestamp
+			}
+		}
+	}
+
+	if window.EndTime.After(now) {
+		window.EndTime = now
+	}
+	if startTime != nil {
+		window.StartTime = *startTime
+	} else {
+		// keep the length of the default window of the period, ending at the requested end time
+		window.StartTime = window.EndTime.Add(-getMonitoringWindowForPeriod(periodDuration))
+	}
+	if oldest := now.Add(-monitoringMetricRetention); window.StartTime.Before(oldest) {
+		window.StartTime = oldest
+	}
+
+	return window, nil
+}
+
+// getMonitoringPeriodDuration parses an MQL interval, which is 1m to 60m, 1h to 24h or 1d
+func getMonitoringPeriodDuration(period string) (time.Duration, error) {
+	matches := monitoringPeriodRegex.FindStringSubmatch(period)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid period %q, must be an interval such as 5m, 1h or 1d", period)
+	}
+	value, _ := strconv.Atoi(matches[1])
+	switch {
+	case matches[2] == "m" && value >= 1 && value <= 60:
+		return time.Duration(value) * time.Minute, nil
+	case matches[2] == "h" && value >= 1 && value <= 24:
+		return time.Duration(value) * time.Hour, nil
+	case matches[2] == "d" && value == 1:
+		return 24 * time.Hour, nil
+	}
+	return 0, fmt.Errorf("invalid period %q, must be 1m to 60m, 1h to 24h or 1d", period)
+}
+
+// getMonitoringWindowForPeriod returns the length of the default time range of a query, which keeps the
+// number of data points of a metric stream bounded whatever the period
+func getMonitoringWindowForPeriod(period time.Duration) time.Duration {
+	switch {
+	case period >= 24*time.Hour:
 		// 90 days (We can fetch upto 90 days maximum)
-		return time.Now().AddDate(0, 0, -90)
-	case "HOURLY":
+		return 90 * 24 * time.Hour
+	case period >= time.Hour:
 		// 60 days
-		return time.Now().AddDate(0, 0, -60)
+		return 60 * 24 * time.Hour
 	}
 	// else 5 days
-	return time.Now().AddDate(0, 0, -5)
+	return 5 * 24 * time.Hour
 }
 
 func getMonitoringPeriodForGranularity(granularity string) string {
@@ -155,138 +266,224 @@ func getMonitoringPeriodForGranularity(granularity string) string {
 	return "5m"
 }
 
-type MetricData struct {
-	CompartmentId *string
-	PointValue    *float64
-	Timestamp     *time.Time
+// Statistics fetched for the metric tables. The mean of a data point is its sum divided by its count,
+// so it does not need a query of its own.
+var monitoringMetricStatistics = []string{"sum", "count", "min", "max"}
+
+// The OCI Monitoring errors returned for queries exceeding the number of metric streams or data points of a
+// single call
+var monitoringTooManyStreamsRegex = regexp.MustCompile(`(?i)(too ?many|exceed).*(stream|data ?point)|(stream|data ?point).*(too ?many|exceed)`)
+
+// monitoringMetricStatisticsCache holds the data points of the metric streams of a compartment, keyed
+// by the value of the grouping dimension, e.g. the resource OCID
+type monitoringMetricStatisticsCache struct {
+	Rows map[string][]*MonitoringMetricRow
+
+	// set when the compartment has too many metric streams to be fetched in a single call
+	PerResource bool
+}
+
+// monitoringMetricStatisticsQuery is the query of the statistics of a metric for all the resources of a compartment
+type monitoringMetricStatisticsQuery struct {
+	Window        *monitoringMetricWindow
+	WindowKey     string
+	Namespace     string
+	MetricName    string
+	DimensionName string
+	CompartmentId string
+	Region        string
 }
 
+// listMonitoringMetricStatistics streams the data points of a metric for a single resource, identified by
+// the value of a dimension of the metric, e.g. resourceId.
+//
+// The statistics are fetched once per compartment and region for all the resources of the compartment,
+// grouped by the dimension, and cached for the other resources. Compartments with too many metric streams
+// for a single call fall back to querying the statistics of each resource.
 func listMonitoringMetricStatistics(ctx context.Context, d *plugin.QueryData, granularity string, namespace string, metricName string, dimensionName string, dimensionValue string, compartmentId string, region string) (*monitoring.SummarizeMetricsDataResponse, error) {
 	plugin.Logger(ctx).Trace("listMonitoringMetricStatistics")
 
-	// Create Session
-	session, err := monitoringService(ctx, d, region)
+	window, err := getMonitoringMetricWindow(d, granularity)
 	if err != nil {
 		return nil, err
 	}
-
-	/**
-	DEFINE QUERY STRING
-	metric[interval]{dimensionname="dimensionvalue"}.groupingfunction.statistic
-	Query should be written with Metric query Language (MQL) https://docs.oracle.com/en-us/iaas/Content/Monitoring/Reference/mql.htm#Interval
-	*/
-	queryString := metricName + "[" + getMonitoringPeriodForGranularity(granularity) + "]" + "{" + dimensionName + " = \"" + dimensionValue + "\"}"
-	queryStringavg := queryString + ".grouping().mean()"
-	querystringMin := queryString + ".grouping().min()"
-	querystringMax := queryString + ".grouping().max()"
-	querystringSum := queryString + ".grouping().sum()"
-	querystringCount := queryString + ".grouping().count()"
-
-	// Set Inteval
-	interval := getMonitoringPeriodForGranularity(granularity)
-	metricDetails := monitoring.SummarizeMetricsDataDetails{
-		Namespace:  &namespace,
-		StartTime:  &common.SDKTime{Time: getMonitoringStartDateForGranularity(granularity)},
-		EndTime:    &common.SDKTime{Time: time.Now()},
-		Query:      &queryStringavg,
-		Resolution: &interval,
+	if !window.StartTime.Before(window.EndTime) {
+		return nil, nil
 	}
 
-	requestParam := monitoring.SummarizeMetricsDataRequest{
-		CompartmentId:               &compartmentId,
-		SummarizeMetricsDataDetails: metricDetails,
-		RequestMetadata: common.RequestMetadata{
-			RetryPolicy: getDefaultRetryPolicy(d.Connection),
-		},
+	// the default window moves with the current time, so it is identified by the granularity and the period only
+	windowKey := strings.ToUpper(granularity)
+	if d.Quals["timestamp"] != nil {
+		windowKey = fmt.Sprintf("%d-%d", window.StartTime.Unix(), window.EndTime.Unix())
 	}
-
-	// Mean statistics
-	avgStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
+	query := &monitoringMetricStatisticsQuery{
+		Window:        window,
+		WindowKey:     windowKey,
+		Namespace:     namespace,
+		MetricName:    metricName,
+		DimensionName: dimensionName,
+		CompartmentId: compartmentId,
+		Region:        region,
+	}
+	cachedData, err := getCompartmentMonitoringMetricStatistics(ctx, d, &plugin.HydrateData{Item: query})
 	if err != nil {
 		return nil, err
 	}
+	statistics := cachedData.(*monitoringMetricStatisticsCache)
 
-	// Min statistics
-	metricDetails.Query = &querystringMin
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	minStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
-	if err != nil {
-		return nil, err
+	rows := statistics.Rows[dimensionValue]
+	if statistics.PerResource {
+		resourceRows, err := getMonitoringMetricStatistics(ctx, d, window, namespace, metricName, dimensionName, dimensionValue, compartmentId, region)
+		if err != nil {
+			return nil, err
+		}
+		rows = resourceRows[dimensionValue]
 	}
-	metricDetailsMin := filterMetricStatistic(minStatistics)
 
-	// Max statistics
-	metricDetails.Query = &querystringMax
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	maxStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
-	if err != nil {
-		return nil, err
+	for _, row := range rows {
+		d.StreamLeafListItem(ctx, row)
 	}
-	metricDetailsMax := filterMetricStatistic(maxStatistics)
 
-	// Sum statistics
-	metricDetails.Query = &querystringSum
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	sumStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
+	return nil, nil
+}
+
+// The statistics of a compartment are memoized, so that the resources of the compartment listed concurrently
+// share the same API calls. 5 minutes is the finest granularity of the metric tables.
+var getCompartmentMonitoringMetricStatistics = plugin.HydrateFunc(getCompartmentMonitoringMetricStatisticsUncached).Memoize(memoize.WithCacheKeyFunction(getCompartmentMonitoringMetricStatisticsCacheKey), memoize.WithTtl(5*time.Minute))
+
+func getCompartmentMonitoringMetricStatisticsCacheKey(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	query := h.Item.(*monitoringMetricStatisticsQuery)
+	key := fmt.Sprintf("listMonitoringMetricStatistics-%s-%s-%s-%s-%s-%s-%s", query.Region, query.CompartmentId, query.Namespace, query.MetricName, query.DimensionName, query.WindowKey, query.Window.Period)
+	return key, nil
+}
+
+func getCompartmentMonitoringMetricStatisticsUncached(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	query := h.Item.(*monitoringMetricStatisticsQuery)
+
+	rows, err := getMonitoringMetricStatistics(ctx, d, query.Window, query.Namespace, query.MetricName, query.DimensionName, "", query.CompartmentId, query.Region)
 	if err != nil {
-		return nil, err
+		// the metric streams of all the resources of the compartment exceed the limit of a single call
+		if !isMonitoringTooManyStreamsError(err) {
+			return nil, err
+		}
+		plugin.Logger(ctx).Debug("listMonitoringMetricStatistics", "compartment", query.CompartmentId, "falling back to per resource queries", err)
+		return &monitoringMetricStatisticsCache{PerResource: true}, nil
 	}
-	metricDetailsSum := filterMetricStatistic(sumStatistics)
 
-	// Count statistics
-	metricDetails.Query = &querystringCount
-	requestParam.SummarizeMetricsDataDetails = metricDetails
-	countStatistics, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
+	return &monitoringMetricStatisticsCache{Rows: rows}, nil
+}
+
+// isMonitoringTooManyStreamsError returns true if a query failed because it matches too many metric streams
+// or data points
+func isMonitoringTooManyStreamsError(err error) bool {
+	ociErr, ok := err.(common.ServiceError)
+	return ok && ociErr.GetHTTPStatusCode() == 400 && monitoringTooManyStreamsRegex.MatchString(ociErr.GetMessage())
+}
+
+// getMonitoringMetricStatistics fetches the statistics of the data points of a metric in a compartment,
+// grouped by the given dimension, and returns the rows keyed by the value of the dimension. If dimensionValue
+// is set, only the data points of the metric streams with that dimension value are fetched.
+func getMonitoringMetricStatistics(ctx context.Context, d *plugin.QueryData, window *monitoringMetricWindow, namespace string, metricName string, dimensionName string, dimensionValue string, compartmentId string, region string) (map[string][]*MonitoringMetricRow, error) {
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
 	if err != nil {
 		return nil, err
 	}
-	metricDetailsCount := filterMetricStatistic(countStatistics)
-
-	for _, item := range avgStatistics.Items {
-		for _, datapoint := range item.AggregatedDatapoints {
-			d.StreamLeafListItem(ctx, &MonitoringMetricRow{
-				CompartmentId:  item.CompartmentId,
-				DimensionValue: &dimensionValue,
-				DimensionName:  &dimensionName,
-				Namespace:      &namespace,
-				MetricName:     &metricName,
-				Average:        datapoint.Value,
-				Maximum:        getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsMax),
-				Minimum:        getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsMin),
-				Timestamp:      &datapoint.Timestamp.Time,
-				SampleCount:    getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsCount),
-				Sum:            getStatisticForColumnByTimestamp(datapoint.Timestamp.Time.UTC(), *item.CompartmentId, metricDetailsSum),
-				Metadata:       item.Metadata,
-				Region:         region,
-			})
-		}
+
+	/**
+	DEFINE QUERY STRING
+	metric[interval]{dimensionname="dimensionvalue"}.groupBy(dimensionname).statistic
+	Query should be written with Metric query Language (MQL) https://docs.oracle.com/en-us/iaas/Content/Monitoring/Reference/mql.htm#Interval
+	*/
+	interval := window.Period
+	queryString := metricName + "[" + interval + "]"
+	if dimensionValue != "" {
+		queryString += "{" + dimensionName + " = \"" + dimensionValue + "\"}"
 	}
+	queryString += ".groupBy(" + dimensionName + ")"
+
+	startTime := window.StartTime
+	endTime := window.EndTime
+
+	rowsByDimension := map[string][]*MonitoringMetricRow{}
+	rowsByTimestamp := map[string]map[time.Time]*MonitoringMetricRow{}
+
+	for _, statistic := range monitoringMetricStatistics {
+		query := queryString + "." + statistic + "()"
+		requestParam := monitoring.SummarizeMetricsDataRequest{
+			CompartmentId: &compartmentId,
+			SummarizeMetricsDataDetails: monitoring.SummarizeMetricsDataDetails{
+				Namespace:  &namespace,
+				StartTime:  &common.SDKTime{Time: startTime},
+				EndTime:    &common.SDKTime{Time: endTime},
+				Query:      &query,
+				Resolution: &interval,
+			},
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
 
-	return nil, err
-}
+		response, err := session.MonitoringClient.SummarizeMetricsData(ctx, requestParam)
+		if err != nil {
+			return nil, err
+		}
 
-func filterMetricStatistic(metricStatistic monitoring.SummarizeMetricsDataResponse) []MetricData {
-	metricData := []MetricData{}
-	for _, item := range metricStatistic.Items {
-		for _, data := range item.AggregatedDatapoints {
-			metricData = append(metricData, MetricData{
-				CompartmentId: item.CompartmentId,
-				PointValue:    data.Value,
-				Timestamp:     &data.Timestamp.Time,
-			})
+		for _, item := range response.Items {
+			value, ok := item.Dimensions[dimensionName]
+			if !ok {
+				continue
+			}
+			if rowsByTimestamp[value] == nil {
+				rowsByTimestamp[value] = map[time.Time]*MonitoringMetricRow{}
+			}
+			for _, datapoint := range item.AggregatedDatapoints {
+				if datapoint.Timestamp == nil {
+					continue
+				}
+				timestamp := datapoint.Timestamp.Time.UTC()
+				row, ok := rowsByTimestamp[value][timestamp]
+				if !ok {
+					dimension := value
+					row = &MonitoringMetricRow{
+						CompartmentId:  item.CompartmentId,
+						DimensionName:  &dimensionName,
+						DimensionValue: &dimension,
+						Namespace:      &namespace,
+						MetricName:     &metricName,
+						Timestamp:      &timestamp,
+						Period:         &interval,
+						Metadata:       item.Metadata,
+						Region:         region,
+					}
+					rowsByTimestamp[value][timestamp] = row
+					rowsByDimension[value] = append(rowsByDimension[value], row)
+				}
+				switch statistic {
+				case "sum":
+					row.Sum = datapoint.Value
+				case "count":
+					row.SampleCount = datapoint.Value
+				case "min":
+					row.Minimum = datapoint.Value
+				case "max":
+					row.Maximum = datapoint.Value
+				}
+			}
 		}
 	}
-	return metricData
-}
 
-func getStatisticForColumnByTimestamp(timestamp time.Time, compartmentId string, metricData []MetricData) *float64 {
-	var value *float64
-	for _, t := range metricData {
-		if *t.Timestamp == timestamp && compartmentId == *t.CompartmentId {
-			value = t.PointValue
-			break
+	for _, rows := range rowsByDimension {
+		for _, row := range rows {
+			if row.Sum != nil && row.SampleCount != nil && *row.SampleCount > 0 {
+				average := *row.Sum / *row.SampleCount
+				row.Average = &average
+			}
 		}
+		sort.Slice(rows, func(i, j int) bool {
+			return rows[i].Timestamp.Before(*rows[j].Timestamp)
+		})
 	}
 
-	return value
+	return rowsByDimension, nil
 }
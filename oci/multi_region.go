@@ -2,13 +2,17 @@ package oci
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
+	"path"
 	"slices"
 	"strings"
 
 	"github.com/oracle/oci-go-sdk/v65/cloudguard"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-go-sdk/v65/resourcesearch"
 	"github.com/turbot/go-kit/types"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
@@ -16,54 +20,273 @@ import (
 const matrixKeyRegion = "region"
 const matrixKeyCompartment = "compartment"
 const matrixKeyZone = "zone"
+const matrixKeyError = "matrix_error"
+
+// matrixError describes why the query matrix could not be built for the connection. Matrix builders
+// can't return errors, so the message is carried in the matrix item and returned by the session
+// constructors, failing only the queries which depend on that matrix item instead of panicking.
+type matrixError struct {
+	Region      string
+	Reason      string
+	Err         error
+	Remediation string
+}
 
-// BuildRegionList :: return a list of matrix items, one per region specified in the connection config
-func BuildRegionList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
-	// retrieve regions from connection config
+func (e *matrixError) Error() string {
+	message := "\n\n" + e.Reason
+	if e.Region != "" {
+		message += " (region: " + e.Region + ")"
+	}
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+	if e.Remediation != "" {
+		message += ". " + e.Remediation
+	}
+	return message
+}
+
+func (e *matrixError) Unwrap() error {
+	return e.Err
+}
+
+// newCompartmentMatrixError converts a failure listing the tenancy compartments into an actionable error
+func newCompartmentMatrixError(err error) *matrixError {
+	var mErr *matrixError
+	if errors.As(err, &mErr) {
+		return mErr
+	}
+	if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
+		return &matrixError{
+			Reason:      "'regions' must be set in the connection configuration",
+			Remediation: "Edit your connection configuration file and then restart Steampipe",
+		}
+	}
+	return &matrixError{
+		Reason:      "unable to list the compartments of the tenancy",
+		Err:         err,
+		Remediation: "Check that the credentials can inspect compartments in the tenancy",
+	}
+}
+
+// getMatrixItemError returns the error recorded in the matrix item being executed, if any
+func getMatrixItemError(d *plugin.QueryData) error {
+	if message := d.EqualsQualString(matrixKeyError); message != "" {
+		return errors.New(message)
+	}
+	return nil
+}
+
+// matrixRegions is the resolved list of regions of a connection, cached per connection
+type matrixRegions struct {
+	Regions []string
+	Errors  map[string]error
+}
+
+// getMatrixRegions returns the regions to query resources in, along with one error per configured
+// region which can't be queried. The regions which are valid are always returned, so queries
+// filtered on them keep working.
+//
+// Entries of the `regions` config can be exact region names, "*" for all the regions the tenancy
+// is subscribed to, or glob patterns such as "us-*" matched against the subscribed regions.
+// Regions matching an entry of `exclude_regions` are removed.
+func getMatrixRegions(ctx context.Context, d *plugin.QueryData) ([]string, map[string]error, error) {
 	ociConfig := GetConfig(d.Connection)
 
-	if ociConfig.Regions != nil {
-		regions := GetConfig(d.Connection).Regions
+	if ociConfig.Regions == nil {
+		region := getDefaultRegion(ociConfig)
+		if region == "" {
+			return nil, nil, &matrixError{
+				Reason:      "'regions' must be set in the connection configuration",
+				Remediation: "Edit your connection configuration file and then restart Steampipe",
+			}
+		}
+		if isExcludedRegion(region, ociConfig.ExcludeRegions) {
+			return nil, nil, nil
+		}
+		return []string{region}, nil, nil
+	}
+
+	cacheKey := "MatrixRegions"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		resolved := cachedData.(*matrixRegions)
+		return resolved.Regions, resolved.Errors, nil
+	}
+
+	var regions, regionPatterns []string
+	for _, region := range ociConfig.Regions {
+		if isRegionPattern(region) {
+			regionPatterns = append(regionPatterns, region)
+		} else {
+			regions = append(regions, region)
+		}
+	}
 
+	regionErrors := map[string]error{}
+	if len(regions) > 0 {
 		// fetch OCI regions
 		validRegions, err := listOciAvailableRegions(ctx, d)
 		if err != nil {
-			panic(err)
+			return nil, nil, err
+		}
+
+		// dedicated regions are not listed by ListRegions, but they are valid if declared in the
+		// connection configuration or subscribed to by the tenancy
+		for region := range ociConfig.DedicatedRegions {
+			validRegions = append(validRegions, region)
 		}
 		invalidRegions := getInvalidRegions(regions, validRegions)
 		if len(invalidRegions) > 0 {
-			panic("\n\nConnection config has invalid regions: " + strings.Join(invalidRegions, ",") + ". Edit your connection configuration file and then restart Steampipe")
+			if subscribedRegions, err := listSubscribedRegions(ctx, d); err == nil {
+				invalidRegions = getInvalidRegions(invalidRegions, subscribedRegions)
+			}
 		}
 
-		// validate regions list
-		matrix := make([]map[string]interface{}, len(regions))
-		for i, region := range regions {
-			matrix[i] = map[string]interface{}{matrixKeyRegion: region}
+		for _, region := range invalidRegions {
+			regionErrors[region] = &matrixError{
+				Region:      region,
+				Reason:      "connection config has invalid region",
+				Remediation: "Edit your connection configuration file and then restart Steampipe",
+			}
 		}
-		return matrix
 	}
 
-	return []map[string]interface{}{
-		{matrixKeyRegion: getRegionFromEnvVar()},
+	var subscribedRegions []string
+	skipUnsubscribed := ociConfig.SkipUnsubscribedRegions != nil && *ociConfig.SkipUnsubscribedRegions
+	if skipUnsubscribed || len(regionPatterns) > 0 {
+		var err error
+		subscribedRegions, err = listSubscribedRegions(ctx, d)
+		if err != nil {
+			return nil, nil, &matrixError{
+				Reason:      "unable to list the regions the tenancy is subscribed to",
+				Err:         err,
+				Remediation: "Check that the credentials can inspect tenancies, or list the regions explicitly in the connection configuration",
+			}
+		}
+	}
+
+	// expand the wildcard entries, keeping the order of the subscribed regions
+	for _, pattern := range regionPatterns {
+		matched := false
+		for _, region := range subscribedRegions {
+			if ok, _ := path.Match(pattern, region); ok {
+				matched = true
+				if !slices.Contains(regions, region) {
+					regions = append(regions, region)
+				}
+			}
+		}
+		if !matched {
+			plugin.Logger(ctx).Warn("getMatrixRegions", "region pattern does not match any subscribed region", pattern)
+		}
+	}
+
+	var resolvedRegions []string
+	for _, region := range regions {
+		if _, ok := regionErrors[region]; ok {
+			continue
+		}
+		if isExcludedRegion(region, ociConfig.ExcludeRegions) {
+			continue
+		}
+		if skipUnsubscribed && !slices.Contains(subscribedRegions, region) {
+			plugin.Logger(ctx).Warn("getMatrixRegions", "skipping region the tenancy is not subscribed to", region)
+			continue
+		}
+		resolvedRegions = append(resolvedRegions, region)
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, &matrixRegions{Regions: resolvedRegions, Errors: regionErrors})
+
+	return resolvedRegions, regionErrors, nil
+}
+
+// isRegionPattern reports whether a `regions` entry is a glob pattern rather than a region name
+func isRegionPattern(region string) bool {
+	return strings.ContainsAny(region, "*?[")
+}
+
+func isExcludedRegion(region string, excludeRegions []string) bool {
+	for _, pattern := range excludeRegions {
+		if ok, _ := path.Match(pattern, region); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getDefaultRegion returns the region used for calls which are not bound to a matrix region, i.e. the
+// first region name in the connection config, the region from the environment or the region of the
+// config file profile
+func getDefaultRegion(config ociConfig) string {
+	for _, region := range config.Regions {
+		if !isRegionPattern(region) {
+			return region
+		}
+	}
+	if region := getRegionFromEnvVar(); region != "" {
+		return region
+	}
+	return getProfileRegion(config)
+}
+
+// getProfileRegion returns the region of the config file profile the connection authenticates with, if any
+func getProfileRegion(config ociConfig) string {
+	// the credentials are set in the connection config, no profile is read
+	if config.Profile == nil && config.UserOCID != nil {
+		return ""
+	}
+
+	profile := "DEFAULT"
+	if config.Profile != nil {
+		profile = *config.Profile
 	}
+	configPath := path.Join(getHomeFolder(), ".oci", "config")
+	if config.ConfigPath != nil && *config.ConfigPath != "" {
+		configPath = expandPath(*config.ConfigPath)
+	}
+
+	settings, err := readProfileSettings(profile, configPath)
+	if err != nil {
+		return ""
+	}
+	return settings["region"]
+}
+
+// BuildRegionList :: return a list of matrix items, one per region specified in the connection config
+func BuildRegionList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+	regions, regionErrors, err := getMatrixRegions(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("BuildRegionList", "error", err)
+		return []map[string]interface{}{{matrixKeyError: err.Error()}}
+	}
+
+	matrix := make([]map[string]interface{}, 0, len(regions)+len(regionErrors))
+	for _, region := range regions {
+		matrix = append(matrix, map[string]interface{}{matrixKeyRegion: region})
+	}
+	for region, regionErr := range regionErrors {
+		matrix = append(matrix, map[string]interface{}{matrixKeyRegion: region, matrixKeyError: regionErr.Error()})
+	}
+	return matrix
 }
 
 // BuildCompartmentList :: return a list of matrix items, one per compartment specified in the connection config
 func BuildCompartmentList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
 	// cache compartment matrix
-	cacheKey := "CompartmentList"
+	cacheKey := fmt.Sprintf("CompartmentList-%s", d.FetchType)
 
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.([]map[string]interface{})
 	}
 
-	// get all the compartments in the tenant
-	compartments, err := listAllCompartments(ctx, d)
+	// get the compartments in scope of the connection
+	compartments, err := listMatrixCompartments(ctx, d)
 	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-		}
-		panic(err)
+		// errors are not cached, so that a transient failure is retried by the next query
+		mErr := newCompartmentMatrixError(err)
+		plugin.Logger(ctx).Error("BuildCompartmentList", "error", mErr)
+		return []map[string]interface{}{{matrixKeyError: mErr.Error()}}
 	}
 
 	// validate compartment list
@@ -80,66 +303,152 @@ func BuildCompartmentList(ctx context.Context, d *plugin.QueryData) []map[string
 // BuildCompartmentRegionList :: return a list of matrix items, one per region-compartment specified in the connection config
 func BuildCompartementRegionList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
 	// cache compartment region matrix
-	cacheKey := "CompartmentRegionList"
+	cacheKey := fmt.Sprintf("CompartmentRegionList-%s", d.FetchType)
 
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.([]map[string]interface{})
 	}
 
-	// get all the compartments in the tenant
-	compartments, err := listAllCompartments(ctx, d)
+	regions, regionErrors, err := getMatrixRegions(ctx, d)
 	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		plugin.Logger(ctx).Error("BuildCompartementRegionList", "error", err)
+		return []map[string]interface{}{{matrixKeyError: err.Error()}}
+	}
+
+	// get the compartments in scope of the connection
+	compartments, err := listMatrixCompartments(ctx, d)
+	if err != nil {
+		// fail every region, but keep the region in the matrix item so region filtered queries report the right error
+		mErr := newCompartmentMatrixError(err)
+		plugin.Logger(ctx).Error("BuildCompartementRegionList", "error", mErr)
+		matrix := make([]map[string]interface{}, 0, len(regions))
+		for _, region := range regions {
+			matrix = append(matrix, map[string]interface{}{matrixKeyRegion: region, matrixKeyError: mErr.Error()})
 		}
-		panic(err)
+		return matrix
+	}
+
+	matrix := make([]map[string]interface{}, 0, len(regions)*len(compartments)+len(regionErrors))
+	for _, region := range regions {
+		for _, compartment := range compartments {
+			matrix = append(matrix, map[string]interface{}{
+				matrixKeyRegion:      region,
+				matrixKeyCompartment: *compartment.Id,
+			})
+			plugin.Logger(ctx).Debug("listAllCompartments Matrix", len(matrix)-1, matrix[len(matrix)-1])
+		}
+	}
+	for region, regionErr := range regionErrors {
+		matrix = append(matrix, map[string]interface{}{matrixKeyRegion: region, matrixKeyError: regionErr.Error()})
 	}
-	// retrieve regions from connection config
-	ociConfig := GetConfig(d.Connection)
 
-	if ociConfig.Regions != nil {
-		regions := GetConfig(d.Connection).Regions
+	// set CompartmentRegionList cache
+	d.ConnectionManager.Cache.Set(cacheKey, matrix)
 
-		// fetch OCI regions
-		validRegions, err := listOciAvailableRegions(ctx, d)
+	return matrix
+}
+
+// BuildCompartementRegionListForResourceType :: return a matrix builder for a resource type of the
+// OCI Resource Search service. When `sparse_compartment_matrix` is enabled, list calls only fan out
+// to the region-compartment pairs where Resource Search finds resources of that type. Otherwise,
+// and for get calls, it returns the same matrix as BuildCompartementRegionList.
+func BuildCompartementRegionListForResourceType(resourceType string) plugin.MatrixItemMapFunc {
+	return func(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
+		ociConfig := GetConfig(d.Connection)
+		if ociConfig.SparseCompartmentMatrix == nil || !*ociConfig.SparseCompartmentMatrix || d.FetchType == "get" {
+			return BuildCompartementRegionList(ctx, d)
+		}
+
+		cacheKey := fmt.Sprintf("CompartmentRegionList-%s", resourceType)
+		if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+			return cachedData.([]map[string]interface{})
+		}
+
+		regions, regionErrors, err := getMatrixRegions(ctx, d)
 		if err != nil {
-			panic(err)
+			return BuildCompartementRegionList(ctx, d)
 		}
-		invalidRegions := getInvalidRegions(regions, validRegions)
-		if len(invalidRegions) > 0 {
-			panic("\n\nConnection config has invalid regions: " + strings.Join(invalidRegions, ",") + ". Edit your connection configuration file and then restart Steampipe")
+		compartments, err := listMatrixCompartments(ctx, d)
+		if err != nil {
+			return BuildCompartementRegionList(ctx, d)
 		}
 
-		// validate regions list
-		matrix := make([]map[string]interface{}, len(regions)*len(compartments))
-		for i, region := range regions {
-			for j, compartment := range compartments {
-				matrix[len(compartments)*i+j] = map[string]interface{}{
+		var matrix []map[string]interface{}
+		for _, region := range regions {
+			resourceCompartments, err := listResourceSearchCompartments(ctx, d, region, resourceType)
+			if err != nil {
+				// fall back to every compartment of the region rather than missing resources
+				plugin.Logger(ctx).Warn("BuildCompartementRegionListForResourceType", "resource_type", resourceType, "region", region, "search_error", err)
+			}
+			for _, compartment := range compartments {
+				if err == nil && !resourceCompartments[*compartment.Id] {
+					continue
+				}
+				matrix = append(matrix, map[string]interface{}{
 					matrixKeyRegion:      region,
 					matrixKeyCompartment: *compartment.Id,
-				}
-				plugin.Logger(ctx).Debug("listAllCompartments Matrix", (len(compartments)*i)+j, matrix[len(compartments)*i+j])
+				})
 			}
 		}
+		for region, regionErr := range regionErrors {
+			matrix = append(matrix, map[string]interface{}{matrixKeyRegion: region, matrixKeyError: regionErr.Error()})
+		}
+
+		// an empty matrix would make the list call run once without any region or compartment,
+		// so keep a single matrix item when no resources are found
+		if len(matrix) == 0 && len(regions) > 0 && len(compartments) > 0 {
+			matrix = append(matrix, map[string]interface{}{
+				matrixKeyRegion:      regions[0],
+				matrixKeyCompartment: *compartments[0].Id,
+			})
+		}
+		plugin.Logger(ctx).Debug("BuildCompartementRegionListForResourceType", "resource_type", resourceType, "matrix_items", len(matrix))
 
-		// set CompartmentRegionList cache
 		d.ConnectionManager.Cache.Set(cacheKey, matrix)
+
 		return matrix
 	}
+}
 
-	defaultMatrix := make([]map[string]interface{}, len(compartments))
-	for j, compartment := range compartments {
-		defaultMatrix[j] = map[string]interface{}{
-			matrixKeyRegion:      getRegionFromEnvVar(),
-			matrixKeyCompartment: *compartment.Id,
-		}
-		plugin.Logger(ctx).Debug("listAllCompartments MATRIX", j, defaultMatrix[j])
+// listResourceSearchCompartments returns the OCIDs of the compartments containing resources of the given type in a region
+func listResourceSearchCompartments(ctx context.Context, d *plugin.QueryData, region string, resourceType string) (map[string]bool, error) {
+	session, err := resourceSearchService(ctx, d, region)
+	if err != nil {
+		return nil, err
 	}
 
-	// set CompartmentRegionList cache
-	d.ConnectionManager.Cache.Set(cacheKey, defaultMatrix)
+	request := resourcesearch.SearchResourcesRequest{
+		Limit: types.Int(1000),
+		SearchDetails: resourcesearch.StructuredSearchDetails{
+			Query: types.String(fmt.Sprintf("query %s resources", resourceType)),
+		},
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
 
-	return defaultMatrix
+	compartments := map[string]bool{}
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.ResourceSearchClient.SearchResources(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range response.Items {
+			if resource.CompartmentId != nil {
+				compartments[*resource.CompartmentId] = true
+			}
+		}
+
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return compartments, nil
 }
 
 func getInvalidRegions(regions []string, ociRegions []string) []string {
@@ -183,7 +492,7 @@ func listAllCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.C
 	// fetch the first region provided in the config file if available
 	var endpointRegion string
 	if GetConfig(d.Connection).Regions != nil {
-		endpointRegion = GetConfig(d.Connection).Regions[0]
+		endpointRegion = getDefaultRegion(GetConfig(d.Connection))
 	}
 
 	pagesLeft := true
@@ -191,7 +500,11 @@ func listAllCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.C
 		response, err := session.IdentityClient.ListCompartments(ctx, request)
 		if err != nil {
 			if strings.Contains(err.Error(), "no such host") && endpointRegion != "" {
-				panic("\n\nConnection config has invalid region: " + endpointRegion + ". Edit your connection configuration file and then restart Steampipe")
+				return nil, &matrixError{
+					Region:      endpointRegion,
+					Reason:      "connection config has invalid region",
+					Remediation: "Edit your connection configuration file and then restart Steampipe",
+				}
 			}
 			plugin.Logger(ctx).Error("listAllCompartments", "ListCompartments", err)
 			return nil, err
@@ -216,91 +529,261 @@ func listAllCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.C
 	return compartments, err
 }
 
-type zoneInfo struct {
-	identity.AvailabilityDomain
-	Region string
-}
-
-func listAllzones(ctx context.Context, d *plugin.QueryData) ([]zoneInfo, error) {
+// listMatrixCompartments returns the compartments in scope of the connection, i.e. the compartments
+// matching `compartments` and their subtrees, minus the compartments matching `exclude_compartments`
+// and their subtrees. Entries can be compartment OCIDs, names or paths such as "prod/network".
+func listMatrixCompartments(ctx context.Context, d *plugin.QueryData) ([]identity.Compartment, error) {
+	ociConfig := GetConfig(d.Connection)
 
-	zonesList := []zoneInfo{}
+	compartments, err := listAllCompartments(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	if ociConfig.Compartments == nil && ociConfig.ExcludeCompartments == nil {
+		return compartments, nil
+	}
 
-	regions := GetConfig(d.Connection).Regions
+	cacheKey := "listMatrixCompartments"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return withRootCompartmentForGet(d, compartments[0], cachedData.([]identity.Compartment)), nil
+	}
 
-	if regions != nil {
-		for _, region := range regions {
-			session, err := identityServiceRegional(ctx, d, region)
-			if err != nil {
-				return nil, err
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	subtrees := func(entries []string) map[string]bool {
+		ids := map[string]bool{}
+		for _, entry := range entries {
+			matched := tree.match(entry)
+			if len(matched) == 0 {
+				plugin.Logger(ctx).Warn("listMatrixCompartments", "compartment entry does not match any compartment", entry)
 			}
-
-			// The OCID of the tenancy containing the compartment.
-			request := identity.ListAvailabilityDomainsRequest{
-				CompartmentId: &session.TenancyID,
-				RequestMetadata: oci_common.RequestMetadata{
-					RetryPolicy: getDefaultRetryPolicy(d.Connection),
-				},
+			for _, id := range matched {
+				for _, subtreeId := range tree.subtree(id) {
+					ids[subtreeId] = true
+				}
 			}
+		}
+		return ids
+	}
 
-			response, err := session.IdentityClient.ListAvailabilityDomains(ctx, request)
-			if err != nil {
-				return nil, err
-			}
+	var included map[string]bool
+	if ociConfig.Compartments != nil {
+		included = subtrees(ociConfig.Compartments)
+	}
+	excluded := subtrees(ociConfig.ExcludeCompartments)
 
-			for _, zones := range response.Items {
-				zonesList = append(zonesList, zoneInfo{zones, region})
-			}
+	var scoped []identity.Compartment
+	for _, compartment := range compartments {
+		if included != nil && !included[*compartment.Id] {
+			continue
+		}
+		if excluded[*compartment.Id] {
+			continue
+		}
+		scoped = append(scoped, compartment)
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, scoped)
+
+	return withRootCompartmentForGet(d, compartments[0], scoped), nil
+}
+
+// Get hydrates only call the API for the matrix items of the root compartment, so the root compartment
+// is kept in the matrix of get calls even when it is out of the scope of the connection
+func withRootCompartmentForGet(d *plugin.QueryData, root identity.Compartment, compartments []identity.Compartment) []identity.Compartment {
+	if d.FetchType != "get" {
+		return compartments
+	}
+	for _, compartment := range compartments {
+		if *compartment.Id == *root.Id {
+			return compartments
 		}
-		return zonesList, nil
 	}
-	region := getRegionFromEnvVar()
-	session, err := identityServiceRegional(ctx, d, region)
+	return append([]identity.Compartment{root}, compartments...)
+}
+
+// getCompartmentTree returns the tree of the compartments returned by listAllCompartments, built once per connection
+func getCompartmentTree(ctx context.Context, d *plugin.QueryData) (*compartmentTree, error) {
+	cacheKey := "getCompartmentTree"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*compartmentTree), nil
+	}
+
+	compartments, err := listAllCompartments(ctx, d)
 	if err != nil {
 		return nil, err
 	}
+	tree := newCompartmentTree(compartments)
 
-	// The OCID of the tenancy containing the compartment.
-	request := identity.ListAvailabilityDomainsRequest{
-		CompartmentId: &session.TenancyID,
-		RequestMetadata: oci_common.RequestMetadata{
-			RetryPolicy: getDefaultRetryPolicy(d.Connection),
-		},
+	d.ConnectionManager.Cache.Set(cacheKey, tree)
+
+	return tree, nil
+}
+
+// compartmentTree indexes the compartments of a tenancy by parent, to resolve paths and subtrees
+type compartmentTree struct {
+	rootId       string
+	compartments map[string]identity.Compartment
+	children     map[string][]string
+}
+
+func newCompartmentTree(compartments []identity.Compartment) *compartmentTree {
+	tree := &compartmentTree{
+		compartments: map[string]identity.Compartment{},
+		children:     map[string][]string{},
 	}
+	for i, compartment := range compartments {
+		// the root compartment is always the first item returned by listAllCompartments
+		if i == 0 {
+			tree.rootId = *compartment.Id
+		}
+		tree.compartments[*compartment.Id] = compartment
+		if compartment.CompartmentId != nil {
+			tree.children[*compartment.CompartmentId] = append(tree.children[*compartment.CompartmentId], *compartment.Id)
+		}
+	}
+	return tree
+}
+
+// path returns the names of the compartment and its ancestors joined by "/", starting with "root", or ""
+// if the compartment or one of its ancestors is not in the tree, e.g. can't be accessed by the connection.
+func (t *compartmentTree) path(id string) string {
+	var names []string
+	for id != t.rootId {
+		compartment, ok := t.compartments[id]
+		if !ok || compartment.CompartmentId == nil {
+			return ""
+		}
+		names = append([]string{types.SafeString(compartment.Name)}, names...)
+		id = *compartment.CompartmentId
+	}
+	return strings.Join(append([]string{"root"}, names...), "/")
+}
 
-	response, err := session.IdentityClient.ListAvailabilityDomains(ctx, request)
+// subtree returns the compartment and all its descendants
+func (t *compartmentTree) subtree(id string) []string {
+	ids := []string{id}
+	for _, child := range t.children[id] {
+		ids = append(ids, t.subtree(child)...)
+	}
+	return ids
+}
+
+// ancestors returns the compartment and its ancestors, ending with the root compartment. Ancestors which
+// the connection can't access are left out.
+func (t *compartmentTree) ancestors(id string) []string {
+	var ids []string
+	for current, ok := t.compartments[id]; ok; current, ok = t.compartments[types.SafeString(current.CompartmentId)] {
+		ids = append(ids, *current.Id)
+		if *current.Id == t.rootId || current.CompartmentId == nil {
+			break
+		}
+	}
+	return ids
+}
+
+// childByName returns the child of a compartment with the given name, matched case-insensitively
+func (t *compartmentTree) childByName(parentId string, name string) (string, bool) {
+	for _, child := range t.children[parentId] {
+		if strings.EqualFold(types.SafeString(t.compartments[child].Name), name) {
+			return child, true
+		}
+	}
+	return "", false
+}
+
+// match returns the compartments identified by an OCID, a path or a name. Names and paths are
+// matched case-insensitively, and a path may omit the leading "root/".
+func (t *compartmentTree) match(entry string) []string {
+	if strings.HasPrefix(entry, "ocid1.") {
+		if _, ok := t.compartments[entry]; ok {
+			return []string{entry}
+		}
+		return nil
+	}
+
+	entry = strings.Trim(entry, "/")
+	var matched []string
+	for id, compartment := range t.compartments {
+		if strings.Contains(entry, "/") || strings.EqualFold(entry, "root") {
+			compartmentPath := t.path(id)
+			if strings.EqualFold(compartmentPath, entry) || strings.EqualFold(compartmentPath, "root/"+entry) {
+				matched = append(matched, id)
+			}
+		} else if strings.EqualFold(types.SafeString(compartment.Name), entry) {
+			matched = append(matched, id)
+		}
+	}
+	return matched
+}
+
+type zoneInfo struct {
+	identity.AvailabilityDomain
+	Region string
+}
+
+func listAllzones(ctx context.Context, d *plugin.QueryData) ([]zoneInfo, error) {
+
+	zonesList := []zoneInfo{}
+
+	regions, _, err := getMatrixRegions(ctx, d)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, zones := range response.Items {
-		zonesList = append(zonesList, zoneInfo{zones, region})
+	for _, region := range regions {
+		session, err := identityServiceRegional(ctx, d, region)
+		if err != nil {
+			return nil, err
+		}
+
+		// The OCID of the tenancy containing the compartment.
+		request := identity.ListAvailabilityDomainsRequest{
+			CompartmentId: &session.TenancyID,
+			RequestMetadata: oci_common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		response, err := session.IdentityClient.ListAvailabilityDomains(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, zones := range response.Items {
+			zonesList = append(zonesList, zoneInfo{zones, region})
+		}
 	}
 	return zonesList, nil
 }
 
 // BuildCompartmentZonalList :: return a list of matrix items, one per zone-compartment specified in the connection config
 func BuildCompartementZonalList(ctx context.Context, d *plugin.QueryData) []map[string]interface{} {
-	cacheKey := "CompartmentZonalList"
+	cacheKey := fmt.Sprintf("CompartmentZonalList-%s", d.FetchType)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
 		return cachedData.([]map[string]interface{})
 	}
 
-	compartments, err := listAllCompartments(ctx, d)
+	compartments, err := listMatrixCompartments(ctx, d)
 	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-		}
-		panic(err)
+		mErr := newCompartmentMatrixError(err)
+		plugin.Logger(ctx).Error("BuildCompartementZonalList", "error", mErr)
+		return []map[string]interface{}{{matrixKeyError: mErr.Error()}}
 	}
 
 	plugin.Logger(ctx).Debug("compartments", "compartments", compartments)
 
 	zones, err := listAllzones(ctx, d)
 	if err != nil {
-		if strings.Contains(err.Error(), "proper configuration for region") || strings.Contains(err.Error(), "OCI_REGION") {
-			panic("\n\n'regions' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		mErr := newCompartmentMatrixError(err)
+		if mErr.Err != nil {
+			mErr.Reason = "unable to list the availability domains of the tenancy"
+			mErr.Remediation = "Check that the configured regions are enabled for the tenancy"
 		}
-		panic(err)
+		plugin.Logger(ctx).Error("BuildCompartementZonalList", "error", mErr)
+		return []map[string]interface{}{{matrixKeyError: mErr.Error()}}
 	}
 
 	matrix := make([]map[string]interface{}, len(zones)*len(compartments))
@@ -328,6 +811,9 @@ func getRegionFromEnvVar() string {
 		return region
 	} else if region, ok = os.LookupEnv("OCI_CLI_REGION"); ok {
 		return region
+	} else if region, ok = os.LookupEnv("OCI_RESOURCE_PRINCIPAL_REGION"); ok {
+		// set by OCI Functions, Data Science jobs and OKE pods using workload identity
+		return region
 	}
 
 	return getEnvSettingWithBlankDefault("region")
@@ -372,7 +858,7 @@ func listOciAvailableRegions(ctx context.Context, d *plugin.QueryData) ([]string
 		return cachedData.([]string), nil
 	}
 
-	endpointRegion := GetConfig(d.Connection).Regions[0]
+	endpointRegion := getDefaultRegion(GetConfig(d.Connection))
 
 	// Create Session
 	session, err := identityService(ctx, d)
@@ -385,7 +871,11 @@ func listOciAvailableRegions(ctx context.Context, d *plugin.QueryData) ([]string
 	regions, err := session.IdentityClient.ListRegions(ctx)
 	if err != nil {
 		if strings.Contains(err.Error(), "no such host") {
-			panic("\n\nConnection config has invalid region: " + endpointRegion + ". Edit your connection configuration file and then restart Steampipe")
+			return nil, &matrixError{
+				Region:      endpointRegion,
+				Reason:      "connection config has invalid region",
+				Remediation: "Edit your connection configuration file and then restart Steampipe. Regions of dedicated or sovereign realms unknown to the plugin must be declared in 'dedicated_regions'",
+			}
 		}
 		logger.Error("listOciAvailableRegions", "ListRegions", err)
 		return nil, err
@@ -399,3 +889,41 @@ func listOciAvailableRegions(ctx context.Context, d *plugin.QueryData) ([]string
 
 	return regionNames, nil
 }
+
+// List out the regions the tenancy is subscribed to
+func listSubscribedRegions(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	cacheKey := "OciSubscribedRegionList"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]string), nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := identity.ListRegionSubscriptionsRequest{
+		TenancyId: &session.TenancyID,
+		RequestMetadata: oci_common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.IdentityClient.ListRegionSubscriptions(ctx, request)
+	if err != nil {
+		plugin.Logger(ctx).Error("listSubscribedRegions", "ListRegionSubscriptions", err)
+		return nil, err
+	}
+
+	var regionNames []string
+	for _, subscription := range response.Items {
+		if subscription.Status == identity.RegionSubscriptionStatusReady {
+			regionNames = append(regionNames, *subscription.RegionName)
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, regionNames)
+
+	return regionNames, nil
+}
@@ -2,31 +2,79 @@ package oci
 
 import (
 	"context"
+	"path"
 	"slices"
 	"strconv"
+	"strings"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
-// function which returns an ErrorPredicate for OCI API calls
-func isNotFoundError(notFoundErrors []string) plugin.ErrorPredicate {
-	return func(err error) bool {
+// function which returns an ErrorPredicateWithContext for OCI API calls, matching the HTTP status code of the error
+// Errors listed in the ignore_error_codes config argument are ignored as well.
+func isNotFoundError(notFoundErrors []string) plugin.ErrorPredicateWithContext {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
 		if ociErr, ok := err.(oci_common.ServiceError); ok {
-			return slices.Contains(notFoundErrors, strconv.Itoa(ociErr.GetHTTPStatusCode()))
+			if slices.Contains(notFoundErrors, strconv.Itoa(ociErr.GetHTTPStatusCode())) {
+				return true
+			}
 		}
-		return false
+		return shouldIgnoreErrorCodes(ctx, d, err)
 	}
 }
 
 // function which returns an ErrorPredicateWithContext for OCI API calls
 // https://docs.oracle.com/en-us/iaas/Content/API/References/apierrors.htm
 // It's advisable to handle errors based on their error codes rather than relying solely on the HTTP status code. This is because different errors can have the same HTTP status code, but they will have distinct error codes.
+// Errors listed in the ignore_error_codes config argument are ignored as well.
 func isNotFoundErrorCode(notFoundErrors []string) plugin.ErrorPredicateWithContext {
 	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
 		if ociErr, ok := err.(oci_common.ServiceError); ok {
-			return slices.Contains(notFoundErrors, ociErr.GetCode())
+			if slices.Contains(notFoundErrors, ociErr.GetCode()) {
+				return true
+			}
 		}
+		return shouldIgnoreErrorCodes(ctx, d, err)
+	}
+}
+
+// shouldIgnoreErrorPluginDefault is the default ignore predicate of the plugin, used by the hydrate
+// functions which do not define their own
+func shouldIgnoreErrorPluginDefault() plugin.ErrorPredicateWithContext {
+	return func(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+		return shouldIgnoreErrorCodes(ctx, d, err)
+	}
+}
+
+// shouldIgnoreErrorCodes checks the OCI error code and the HTTP status code of a service error against the
+// ignore_error_codes config argument. Entries may contain wildcards, e.g. "NotAuthorized*" or "40?".
+func shouldIgnoreErrorCodes(ctx context.Context, d *plugin.QueryData, err error) bool {
+	if d == nil {
 		return false
 	}
+	ignoreErrorCodes := GetConfig(d.Connection).IgnoreErrorCodes
+	if len(ignoreErrorCodes) == 0 {
+		return false
+	}
+
+	ociErr, ok := err.(oci_common.ServiceError)
+	if !ok {
+		return false
+	}
+	code := strings.ToLower(ociErr.GetCode())
+	statusCode := strconv.Itoa(ociErr.GetHTTPStatusCode())
+
+	for _, pattern := range ignoreErrorCodes {
+		pattern = strings.ToLower(pattern)
+		if matched, _ := path.Match(pattern, code); matched && code != "" {
+			plugin.Logger(ctx).Debug("shouldIgnoreErrorCodes", "ignore_error_code", pattern, "error", err)
+			return true
+		}
+		if matched, _ := path.Match(pattern, statusCode); matched {
+			plugin.Logger(ctx).Debug("shouldIgnoreErrorCodes", "ignore_error_code", pattern, "error", err)
+			return true
+		}
+	}
+	return false
 }
@@ -9,7 +9,9 @@ package oci
 import (
 	"context"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
@@ -17,11 +19,21 @@ const pluginName = "steampipe-plugin-oci"
 
 // Plugin creates this (oci) plugin
 func Plugin(ctx context.Context) *plugin.Plugin {
+	// the retry policies have no context to get the logger from
+	if logger, ok := ctx.Value(context_key.Logger).(hclog.Logger); ok {
+		retryLogger = logger
+	}
+
 	p := &plugin.Plugin{
 		Name:             pluginName,
 		DefaultTransform: transform.FromGo(),
 		DefaultGetConfig: &plugin.GetConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
+		},
+		DefaultIgnoreConfig: &plugin.IgnoreConfig{
+			ShouldIgnoreErrorFunc: shouldIgnoreErrorPluginDefault(),
 		},
 		ConnectionKeyColumns: []plugin.ConnectionKeyColumn{
 			{
@@ -32,6 +44,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		ConnectionConfigSchema: &plugin.ConnectionConfigSchema{
 			NewInstance: ConfigInstance,
 		},
+		RateLimiters: rateLimiters(),
 		TableMap: map[string]*plugin.Table{
 			"oci_adm_knowledge_base":                                       tableAdmKnowledgeBase(ctx),
 			"oci_adm_vulnerability_audit":                                  tableAdmVulnerabilityAudit(ctx),
@@ -48,6 +61,7 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_artifacts_container_repository":                           tableArtifactContainerRepository(ctx),
 			"oci_artifacts_generic_artifact":                               tableArtifactGenericArtifact(ctx),
 			"oci_artifacts_repository":                                     tableArtifactRepository(ctx),
+			"oci_audit_event":                                              tableAuditEvent(ctx),
 			"oci_autoscaling_auto_scaling_configuration":                   tableAutoScalingConfiguration(ctx),
 			"oci_autoscaling_auto_scaling_policy":                          tableAutoScalingPolicy(ctx),
 			"oci_bastion_bastion":                                          tableBastion(ctx),
@@ -148,10 +162,20 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_identity_customer_secret_key":                             tableIdentityCustomerSecretKey(ctx),
 			"oci_identity_db_credential":                                   tableIdentityDBCredential(ctx),
 			"oci_identity_domain":                                          tableIdentityDomain(ctx),
+			"oci_identity_domain_app":                                      tableIdentityDomainApp(ctx),
+			"oci_identity_domain_authentication_factor_setting":            tableIdentityDomainAuthenticationFactorSetting(ctx),
+			"oci_identity_domain_group":                                    tableIdentityDomainGroup(ctx),
+			"oci_identity_domain_group_member":                             tableIdentityDomainGroupMember(ctx),
+			"oci_identity_domain_password_policy":                          tableIdentityDomainPasswordPolicy(ctx),
+			"oci_identity_domain_sign_on_policy":                           tableIdentityDomainSignOnPolicy(ctx),
+			"oci_identity_domain_user":                                     tableIdentityDomainUser(ctx),
 			"oci_identity_dynamic_group":                                   tableIdentityDynamicGroup(ctx),
+			"oci_identity_dynamic_group_member":                            tableIdentityDynamicGroupMember(ctx),
+			"oci_identity_effective_permission":                            tableIdentityEffectivePermission(ctx),
 			"oci_identity_group":                                           tableIdentityGroup(ctx),
 			"oci_identity_network_source":                                  tableIdentityNetworkSource(ctx),
 			"oci_identity_policy":                                          tableIdentityPolicy(ctx),
+			"oci_identity_policy_statement":                                tableIdentityPolicyStatement(ctx),
 			"oci_identity_tag_default":                                     tableIdentityTagDefault(ctx),
 			"oci_identity_tag_namespace":                                   tableIdentityTagNamespace(ctx),
 			"oci_identity_tenancy":                                         tableIdentityTenancy(ctx),
@@ -162,6 +186,10 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			"oci_logging_log_group":                                        tableLoggingLogGroup(ctx),
 			"oci_logging_log":                                              tableLoggingLog(ctx),
 			"oci_logging_search":                                           tableLoggingSearch(ctx),
+			"oci_monitoring_alarm":                                         tableMonitoringAlarm(ctx),
+			"oci_monitoring_alarm_history":                                 tableMonitoringAlarmHistory(ctx),
+			"oci_monitoring_alarm_status":                                  tableMonitoringAlarmStatus(ctx),
+			"oci_monitoring_metric_data":                                   tableMonitoringMetricData(ctx),
 			"oci_mysql_backup":                                             tableMySQLBackup(ctx),
 			"oci_mysql_channel":                                            tableMySQLChannel(ctx),
 			"oci_mysql_configuration_custom":                               tableMySQLConfigurationCustom(ctx),
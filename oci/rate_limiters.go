@@ -0,0 +1,110 @@
+package oci
+
+import (
+	"fmt"
+	"net/http"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/rate_limiter"
+	"golang.org/x/time/rate"
+)
+
+// Default rate limiters for the most used OCI services. Hydrate functions are tagged with the
+// "service" they call, and one limiter instance is created per connection, region and service,
+// so that concurrent queries share the request budget of an endpoint instead of stampeding it.
+//
+// The definitions can be overridden by name with `limiter` blocks in the plugin configuration.
+func rateLimiters() []*rate_limiter.Definition {
+	return []*rate_limiter.Definition{
+		{
+			Name:       "oci_compute",
+			FillRate:   10,
+			BucketSize: 20,
+			Scope:      []string{"connection", "region", "service"},
+			Where:      "service = 'compute'",
+		},
+		{
+			Name:       "oci_blockstorage",
+			FillRate:   10,
+			BucketSize: 20,
+			Scope:      []string{"connection", "region", "service"},
+			Where:      "service = 'blockstorage'",
+		},
+		{
+			Name:       "oci_virtualnetwork",
+			FillRate:   10,
+			BucketSize: 20,
+			Scope:      []string{"connection", "region", "service"},
+			Where:      "service = 'virtualnetwork'",
+		},
+		{
+			Name:       "oci_identity",
+			FillRate:   10,
+			BucketSize: 20,
+			Scope:      []string{"connection", "service"},
+			Where:      "service = 'identity'",
+		},
+		{
+			Name:       "oci_monitoring",
+			FillRate:   8,
+			BucketSize: 10,
+			Scope:      []string{"connection", "region", "service"},
+			Where:      "service = 'monitoring'",
+		},
+		{
+			Name:       "oci_objectstorage",
+			FillRate:   20,
+			BucketSize: 40,
+			Scope:      []string{"connection", "region", "service"},
+			Where:      "service = 'objectstorage'",
+		},
+		{
+			Name:       "oci_database",
+			FillRate:   10,
+			BucketSize: 20,
+			Scope:      []string{"connection", "region", "service"},
+			Where:      "service = 'database'",
+		},
+	}
+}
+
+// getConnectionRateLimiter returns the client side limiter for a service in a region, if the
+// `rate_limits` connection option sets a limit for the service. Limiters are shared by every
+// client of the connection for that service and region.
+func getConnectionRateLimiter(d *plugin.QueryData, service string, region string) *rate.Limiter {
+	limit, ok := GetConfig(d.Connection).RateLimits[service]
+	if !ok || limit <= 0 {
+		return nil
+	}
+
+	cacheKey := fmt.Sprintf("rateLimiter-%s-%s", service, region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*rate.Limiter)
+	}
+
+	// allow short bursts of up to one second worth of requests
+	burst := int(limit)
+	if burst < 1 {
+		burst = 1
+	}
+	limiter := rate.NewLimiter(rate.Limit(limit), burst)
+	d.ConnectionManager.Cache.Set(cacheKey, limiter)
+
+	return limiter
+}
+
+// rateLimitInterceptor waits for the connection rate limiter of the service before each request
+// is signed and sent, chaining any interceptor already set on the client. The interceptor is set
+// on clients cached for the connection, so it only uses the context of the request it intercepts.
+func rateLimitInterceptor(limiter *rate.Limiter, next oci_common.RequestInterceptor) oci_common.RequestInterceptor {
+	return func(request *http.Request) error {
+		if err := limiter.Wait(request.Context()); err != nil {
+			return err
+		}
+		if next != nil {
+			return next(request)
+		}
+		return nil
+	}
+}
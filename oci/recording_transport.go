@@ -0,0 +1,284 @@
+package oci
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	recordingModeRecord = "record"
+	recordingModeReplay = "replay"
+)
+
+// Response headers kept in the fixtures. Other headers are dropped, since they are either irrelevant to
+// the plugin or vary between runs.
+var recordedResponseHeaders = []string{
+	"Content-Type",
+	"Etag",
+	"Opc-Next-Page",
+	"Opc-Prev-Page",
+	"Opc-Total-Items",
+	"Retry-After",
+}
+
+// JSON fields whose string values are redacted from the recorded responses. Fields are matched
+// case-insensitively, on the whole name or on names containing one of the sensitive words.
+var (
+	redactedResponseFields = []string{"token", "content", "secretvalue"}
+	redactedResponseWords  = []string{"password", "passphrase", "privatekey"}
+)
+
+// OCIDs in the URLs, request bodies and responses are replaced by placeholders before being written to the
+// fixtures or used to look them up, so that fixtures do not leak the OCIDs of the recorded tenancy. The
+// placeholder keeps the resource type, realm and region of the OCID, and replaces its unique ID by a hash
+// of the OCID. Tenancy OCIDs, which come from the connection credentials, share a fixed placeholder so that
+// fixtures replay with any credentials.
+var ocidPattern = regexp.MustCompile(`ocid1\.([a-z0-9_-]+)\.([a-z0-9-]+)\.([a-z0-9._-]*)\.([a-z0-9]+)`)
+
+const sanitizedOcidPrefix = "sanitized"
+
+// recordedResponse is the fixture file format of a recorded API call
+type recordedResponse struct {
+	Method     string              `json:"method"`
+	URL        string              `json:"url"`
+	StatusCode int                 `json:"status_code"`
+	Header     map[string][]string `json:"header,omitempty"`
+	Body       json.RawMessage     `json:"body,omitempty"`
+	RawBody    string              `json:"raw_body,omitempty"`
+}
+
+// recordingTransport is an http.RoundTripper which either records the OCI API responses to fixture
+// files in a directory, or replays them without calling OCI. Fixtures are keyed by method, URL and
+// request body, so the same queries replay the same responses.
+type recordingTransport struct {
+	mode string
+	dir  string
+	next http.RoundTripper
+	lock sync.Mutex
+}
+
+func newRecordingTransport(mode string, dir string, next http.RoundTripper) (*recordingTransport, error) {
+	if mode != recordingModeRecord && mode != recordingModeReplay {
+		return nil, fmt.Errorf("invalid http_recording_mode %q, must be one of %q or %q", mode, recordingModeRecord, recordingModeReplay)
+	}
+	if dir == "" {
+		return nil, fmt.Errorf("http_recording_dir must be set when http_recording_mode is %q", mode)
+	}
+	if mode == recordingModeRecord {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create http_recording_dir %s: %v", dir, err)
+		}
+	}
+	return &recordingTransport{mode: mode, dir: dir, next: next}, nil
+}
+
+func (t *recordingTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if request.Body != nil {
+		var err error
+		requestBody, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		request.Body = io.NopCloser(bytes.NewReader(requestBody))
+	}
+	fixturePath := filepath.Join(t.dir, recordingKey(request, sanitizeOcids(requestBody))+".json")
+
+	if t.mode == recordingModeReplay {
+		return t.replay(request, fixturePath)
+	}
+
+	response, err := t.next.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.record(request, response, fixturePath); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (t *recordingTransport) record(request *http.Request, response *http.Response, fixturePath string) error {
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	recorded := recordedResponse{
+		Method:     request.Method,
+		URL:        sanitizedURL(request),
+		StatusCode: response.StatusCode,
+		Header:     map[string][]string{},
+	}
+	for _, name := range recordedResponseHeaders {
+		for _, value := range response.Header.Values(name) {
+			recorded.Header[name] = append(recorded.Header[name], string(sanitizeOcids([]byte(value))))
+		}
+	}
+
+	var document interface{}
+	if len(body) > 0 && json.Unmarshal(body, &document) == nil {
+		redacted, err := json.Marshal(redactResponseFields(document))
+		if err != nil {
+			return err
+		}
+		recorded.Body = sanitizeOcids(redacted)
+	} else {
+		recorded.RawBody = string(sanitizeOcids(body))
+	}
+
+	// keep the URLs of the fixtures readable
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(recorded); err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return os.WriteFile(fixturePath, data.Bytes(), 0600)
+}
+
+func (t *recordingTransport) replay(request *http.Request, fixturePath string) (*http.Response, error) {
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recorded response for %s %s in %s", request.Method, sanitizedURL(request), t.dir)
+		}
+		return nil, err
+	}
+
+	var recorded recordedResponse
+	if err := json.Unmarshal(data, &recorded); err != nil {
+		return nil, fmt.Errorf("invalid recorded response %s: %v", fixturePath, err)
+	}
+
+	body := []byte(recorded.RawBody)
+	if len(recorded.Body) > 0 {
+		body = recorded.Body
+	}
+	header := http.Header{}
+	for name, values := range recorded.Header {
+		for _, value := range values {
+			header.Add(name, value)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       request,
+	}, nil
+}
+
+// recordingKey identifies a request by its method, URL with sorted query parameters and body
+func recordingKey(request *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(request.Method + " " + sanitizedURL(request) + "\n"))
+	hash.Write(body)
+
+	// keep the start of the path in the file name, so fixtures are easy to find
+	name := strings.Trim(strings.NewReplacer("/", "_", ".", "_").Replace(string(sanitizeOcids([]byte(request.URL.Path)))), "_")
+	if len(name) > 60 {
+		name = name[:60]
+	}
+	return fmt.Sprintf("%s_%s_%s", strings.ToLower(request.Method), name, hex.EncodeToString(hash.Sum(nil))[:16])
+}
+
+// sanitizedURL returns the request URL with its query parameters sorted and its OCIDs sanitized
+func sanitizedURL(request *http.Request) string {
+	query := request.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var params []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, key+"="+value)
+		}
+	}
+
+	url := fmt.Sprintf("%s://%s%s", request.URL.Scheme, request.URL.Host, request.URL.Path)
+	if len(params) > 0 {
+		url += "?" + strings.Join(params, "&")
+	}
+	return string(sanitizeOcids([]byte(url)))
+}
+
+// sanitizeOcids replaces the OCIDs of a URL or body by their placeholder. Placeholders are left unchanged,
+// so that the requests built from replayed responses match the fixtures they were recorded in.
+func sanitizeOcids(data []byte) []byte {
+	return ocidPattern.ReplaceAllFunc(data, func(ocid []byte) []byte {
+		match := ocidPattern.FindSubmatch(ocid)
+		resourceType, realm, region, uniqueId := string(match[1]), string(match[2]), string(match[3]), string(match[4])
+		if strings.HasPrefix(uniqueId, sanitizedOcidPrefix) {
+			return ocid
+		}
+		if resourceType == "tenancy" {
+			return []byte(fmt.Sprintf("ocid1.tenancy.%s..%s", realm, sanitizedOcidPrefix))
+		}
+		hash := sha256.Sum256(ocid)
+		return []byte(fmt.Sprintf("ocid1.%s.%s.%s.%s%s", resourceType, realm, region, sanitizedOcidPrefix, hex.EncodeToString(hash[:12])))
+	})
+}
+
+func redactResponseFields(value interface{}) interface{} {
+	switch item := value.(type) {
+	case map[string]interface{}:
+		for key, field := range item {
+			if isRedactedResponseField(key) {
+				if _, ok := field.(string); ok {
+					item[key] = "REDACTED"
+					continue
+				}
+			}
+			item[key] = redactResponseFields(field)
+		}
+	case []interface{}:
+		for i, element := range item {
+			item[i] = redactResponseFields(element)
+		}
+	}
+	return value
+}
+
+func isRedactedResponseField(name string) bool {
+	name = strings.ToLower(name)
+	if slices.Contains(redactedResponseFields, name) {
+		return true
+	}
+	for _, word := range redactedResponseWords {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
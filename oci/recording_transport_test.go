@@ -0,0 +1,123 @@
+package oci
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSanitizeOcids(t *testing.T) {
+	instance := "ocid1.instance.oc1.iad.anuwcljrexampleuniqueid"
+	sanitized := string(sanitizeOcids([]byte(instance)))
+
+	if !strings.HasPrefix(sanitized, "ocid1.instance.oc1.iad."+sanitizedOcidPrefix) {
+		t.Errorf("sanitizeOcids(%q) = %q, want the resource type, realm and region to be kept", instance, sanitized)
+	}
+	if again := string(sanitizeOcids([]byte(sanitized))); again != sanitized {
+		t.Errorf("sanitizeOcids(%q) = %q, want placeholders to be left unchanged", sanitized, again)
+	}
+	if other := string(sanitizeOcids([]byte("ocid1.instance.oc1.iad.anuwcljrotheruniqueid"))); other == sanitized {
+		t.Errorf("sanitizeOcids returned %q for two different OCIDs", other)
+	}
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"ocid1.tenancy.oc1..aaaaaaaaexampletenancy", "ocid1.tenancy.oc1..sanitized"},
+		{"ocid1.tenancy.oc2..aaaaaaaaothertenancy", "ocid1.tenancy.oc2..sanitized"},
+		{"/20160918/instances/" + instance + "/vnics", "/20160918/instances/" + sanitized + "/vnics"},
+		{`{"id":"` + instance + `","name":"ocid"}`, `{"id":"` + sanitized + `","name":"ocid"}`},
+		{"no ocid here", "no ocid here"},
+	}
+	for _, test := range tests {
+		if got := string(sanitizeOcids([]byte(test.input))); got != test.want {
+			t.Errorf("sanitizeOcids(%q) = %q, want %q", test.input, got, test.want)
+		}
+	}
+}
+
+func TestRecordingTransport(t *testing.T) {
+	const (
+		tenancy  = "ocid1.tenancy.oc1..aaaaaaaarecordedtenancy"
+		instance = "ocid1.instance.oc1.iad.anuwcljrrecordedinstance"
+	)
+	responseBody := `{"id":"` + instance + `","compartmentId":"` + tenancy + `","adminPassword":"secret"}`
+
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Opc-Request-Id", "request-id")
+		io.WriteString(w, responseBody)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	get := func(transport http.RoundTripper, path string) (*http.Response, error) {
+		request, err := http.NewRequest(http.MethodGet, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return transport.RoundTrip(request)
+	}
+
+	recorder, err := newRecordingTransport(recordingModeRecord, dir, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := get(recorder, "/20160918/instances/"+instance+"?compartmentId="+tenancy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(response.Body)
+	if string(body) != responseBody {
+		t.Errorf("recorded response body = %s, want the response of the server %s", body, responseBody)
+	}
+
+	// the fixture must not contain the OCIDs, secrets or dropped headers of the response
+	fixtures, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(fixtures) != 1 {
+		t.Fatalf("found %d fixtures, want 1", len(fixtures))
+	}
+	fixture, _ := os.ReadFile(fixtures[0])
+	for _, leaked := range []string{"recordedtenancy", "recordedinstance", "secret", "request-id"} {
+		if strings.Contains(string(fixture), leaked) {
+			t.Errorf("fixture %s contains %q:\n%s", fixtures[0], leaked, fixture)
+		}
+	}
+
+	replayer, err := newRecordingTransport(recordingModeReplay, dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sanitizedInstance := string(sanitizeOcids([]byte(instance)))
+	paths := []string{
+		// the request recorded
+		"/20160918/instances/" + instance + "?compartmentId=" + tenancy,
+		// the same request built from a replayed response, with the credentials of another tenancy
+		"/20160918/instances/" + sanitizedInstance + "?compartmentId=ocid1.tenancy.oc1..aaaaaaaaothertenancy",
+	}
+	for _, path := range paths {
+		response, err := get(replayer, path)
+		if err != nil {
+			t.Errorf("replay of %s failed: %v", path, err)
+			continue
+		}
+		body, _ := io.ReadAll(response.Body)
+		if response.StatusCode != http.StatusOK || !strings.Contains(string(body), sanitizedInstance) || !strings.Contains(string(body), "REDACTED") {
+			t.Errorf("replay of %s = %d %s, want the sanitized recorded response", path, response.StatusCode, body)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("server was called %d times, want 1", calls)
+	}
+
+	if _, err := get(replayer, "/20160918/instances"); err == nil || !strings.Contains(err.Error(), "no recorded response") {
+		t.Errorf("replay of a request without fixture returned error %v, want a missing fixture error", err)
+	}
+}
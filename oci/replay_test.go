@@ -0,0 +1,302 @@
+package oci
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/anywhere"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// TestTableFixtures runs the list, get and key column paths of tables against recorded API responses,
+// without calling OCI. Each subdirectory of the fixtures directory holds the fixtures of the table it is
+// named after, and API calls without a fixture fail the test of the table. The fixtures directory defaults
+// to testdata/fixtures, and can be set with OCI_FIXTURES_DIR:
+//
+//	OCI_FIXTURES_DIR=/path/to/fixtures go test ./oci -run TestTableFixtures
+//
+// The fixtures are recorded by running the same test against a live tenancy with OCI_FIXTURES_MODE=record.
+// The credentials are then read from the OCI config file, or from the OCI_FIXTURES_PROFILE profile of it.
+// All the tables are recorded, unless OCI_FIXTURES_TABLES lists the ones to record, separated by commas.
+// OCI_FIXTURES_REGION sets the region the tables are queried in, and defaults to us-ashburn-1. It must be
+// the same when recording and replaying, since the fixtures are keyed by the URLs of the API calls.
+func TestTableFixtures(t *testing.T) {
+	dir := os.Getenv("OCI_FIXTURES_DIR")
+	if dir == "" {
+		dir = filepath.Join("testdata", "fixtures")
+	}
+	mode := os.Getenv("OCI_FIXTURES_MODE")
+	if mode == "" {
+		mode = recordingModeReplay
+	}
+	region := os.Getenv("OCI_FIXTURES_REGION")
+	if region == "" {
+		region = "us-ashburn-1"
+	}
+
+	var credentials string
+	if mode == recordingModeReplay {
+		// replayed requests are signed, but the credentials do not need to be valid
+		credentials = fixtureCredentials(t)
+	} else if profile := os.Getenv("OCI_FIXTURES_PROFILE"); profile != "" {
+		credentials = fmt.Sprintf("config_file_profile = %q\n", profile)
+	}
+
+	tables := Plugin(context.Background()).TableMap
+	names, err := fixtureTableNames(dir, mode, tables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) == 0 {
+		t.Fatalf("no table fixtures found in %s", dir)
+	}
+
+	// each table gets a connection recording to or replaying from its own directory
+	configs := make([]*proto.ConnectionConfig, 0, len(names))
+	for _, name := range names {
+		configs = append(configs, &proto.ConnectionConfig{
+			Connection: name,
+			Plugin:     "oci",
+			Config: fmt.Sprintf("regions = [%q]\nhttp_recording_mode = %q\nhttp_recording_dir = %q\n",
+				region, mode, filepath.Join(dir, name)) + credentials,
+		})
+	}
+	server := plugin.Server(&plugin.ServeOpts{PluginFunc: Plugin})
+	response, err := server.SetAllConnectionConfigs(&proto.SetAllConnectionConfigsRequest{
+		Configs:        configs,
+		MaxCacheSizeMb: 64,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(response.FailedConnections) > 0 {
+		t.Fatalf("failed to set the connection configs: %v", response.FailedConnections)
+	}
+
+	for _, name := range names {
+		table := tables[name]
+		t.Run(name, func(t *testing.T) {
+			runner := &fixtureRunner{server: server, connection: name, mode: mode}
+			runner.testTable(t, table)
+		})
+	}
+}
+
+// fixtureTableNames returns the sorted names of the tables to record, or of the tables which have fixtures
+// in the directory when replaying
+func fixtureTableNames(dir string, mode string, tables map[string]*plugin.Table) ([]string, error) {
+	var names []string
+	if list := os.Getenv("OCI_FIXTURES_TABLES"); list != "" {
+		names = strings.Split(list, ",")
+	} else if mode == recordingModeRecord {
+		for name := range tables {
+			names = append(names, name)
+		}
+	} else {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				names = append(names, entry.Name())
+			}
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := tables[name]; !ok {
+			return nil, fmt.Errorf("unknown table %s", name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// fixtureCredentials returns the connection config of throwaway API key credentials
+func fixtureCredentials(t *testing.T) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPath := filepath.Join(t.TempDir(), "key.pem")
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(keyPath, keyPem, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return fmt.Sprintf("tenancy_ocid = %q\nuser_ocid = %q\nfingerprint = %q\nprivate_key_path = %q\n",
+		"ocid1.tenancy.oc1..fixtures", "ocid1.user.oc1..fixtures", "00:00:00:00:00:00:00:00:00:00:00:00:00:00:00:00", keyPath)
+}
+
+type fixtureRunner struct {
+	server     *grpc.PluginServer
+	connection string
+	mode       string
+	calls      atomic.Int64
+}
+
+func (r *fixtureRunner) testTable(t *testing.T, table *plugin.Table) {
+	if table.List == nil || hasRequiredKeyColumns(table.List.KeyColumns) {
+		t.Skip("the table can only be queried with quals")
+	}
+
+	rows := r.query(t, table, "list", nil)
+	t.Logf("list returned %d rows", len(rows))
+	if len(rows) == 0 {
+		// a table without resources in the recorded tenancy is recorded, but its fixtures test nothing
+		if r.mode == recordingModeRecord {
+			t.Skip("no rows recorded")
+		}
+		t.Fatal("the fixtures return no rows")
+	}
+	// rows are streamed in any order, so the same row is picked on every run to match the recorded calls
+	row := rows[0]
+	for _, other := range rows[1:] {
+		if fixtureRowKey(other) < fixtureRowKey(row) {
+			row = other
+		}
+	}
+
+	// get the first row by its get key columns
+	if table.Get != nil {
+		quals := map[string]*proto.Quals{}
+		for _, column := range table.Get.KeyColumns {
+			value := fixtureQualValue(row[column.Name])
+			if value == nil {
+				if column.Require == plugin.AnyOf || column.Require == plugin.Optional {
+					continue
+				}
+				quals = nil
+				break
+			}
+			quals[column.Name] = equalsQual(column.Name, value)
+		}
+		if len(quals) > 0 {
+			if got := r.query(t, table, "get", quals); len(got) == 0 {
+				t.Errorf("get with %v returned no rows", qualNames(quals))
+			}
+		}
+	}
+
+	// filter the list on the value of each key column in the first row
+	for _, column := range table.List.KeyColumns {
+		if column.Operators != nil && !slices.Contains(column.Operators, "=") {
+			continue
+		}
+		if value := fixtureQualValue(row[column.Name]); value != nil {
+			r.query(t, table, "key column "+column.Name, map[string]*proto.Quals{column.Name: equalsQual(column.Name, value)})
+		}
+	}
+}
+
+// query runs a query on a table and returns its rows. The test fails if an API call of the query was not
+// recorded, e.g. because the request of a table changed since its fixtures were recorded.
+func (r *fixtureRunner) query(t *testing.T, table *plugin.Table, path string, quals map[string]*proto.Quals) []map[string]*proto.Column {
+	t.Helper()
+
+	columns := make([]string, 0, len(table.Columns))
+	for _, column := range table.Columns {
+		columns = append(columns, column.Name)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	stream := anywhere.NewLocalPluginStream(ctx)
+	r.server.CallExecuteAsync(&proto.ExecuteRequest{
+		Table:        table.Name,
+		QueryContext: &proto.QueryContext{Columns: columns, Quals: quals},
+		Connection:   r.connection,
+		CallId:       fmt.Sprintf("%s-%d", table.Name, r.calls.Add(1)),
+		ExecuteConnectionData: map[string]*proto.ExecuteConnectionData{
+			r.connection: {},
+		},
+	}, stream)
+
+	var rows []map[string]*proto.Column
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			if strings.Contains(err.Error(), "has been deprecated") {
+				t.Skipf("%s: %v", path, err)
+			}
+			t.Fatalf("%s: %v", path, err)
+		}
+		if response == nil {
+			return rows
+		}
+		if response.Row != nil {
+			rows = append(rows, response.Row.Columns)
+		}
+	}
+}
+
+func hasRequiredKeyColumns(columns plugin.KeyColumnSlice) bool {
+	for _, column := range columns {
+		if column.Require == "" || column.Require == plugin.Required || column.Require == plugin.AnyOf {
+			return true
+		}
+	}
+	return false
+}
+
+// fixtureRowKey returns the id of a row, or its title for tables without id column
+func fixtureRowKey(row map[string]*proto.Column) string {
+	for _, name := range []string{"id", "title"} {
+		if value, ok := row[name].GetValue().(*proto.Column_StringValue); ok {
+			return value.StringValue
+		}
+	}
+	return ""
+}
+
+// fixtureQualValue converts a column value to a qual value, if it has a scalar value
+func fixtureQualValue(column *proto.Column) *proto.QualValue {
+	if column == nil {
+		return nil
+	}
+	switch value := column.Value.(type) {
+	case *proto.Column_StringValue:
+		return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: value.StringValue}}
+	case *proto.Column_IntValue:
+		return &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: value.IntValue}}
+	case *proto.Column_DoubleValue:
+		return &proto.QualValue{Value: &proto.QualValue_DoubleValue{DoubleValue: value.DoubleValue}}
+	case *proto.Column_BoolValue:
+		return &proto.QualValue{Value: &proto.QualValue_BoolValue{BoolValue: value.BoolValue}}
+	case *proto.Column_TimestampValue:
+		return &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: value.TimestampValue}}
+	}
+	return nil
+}
+
+func equalsQual(column string, value *proto.QualValue) *proto.Quals {
+	return &proto.Quals{Quals: []*proto.Qual{{
+		FieldName: column,
+		Operator:  &proto.Qual_StringValue{StringValue: "="},
+		Value:     value,
+	}}}
+}
+
+func qualNames(quals map[string]*proto.Quals) []string {
+	names := make([]string, 0, len(quals))
+	for name := range quals {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
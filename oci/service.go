@@ -2,17 +2,25 @@ package oci
 
 import (
 	"context"
+	"crypto/rsa"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/user"
 	"path"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/oracle/oci-go-sdk/v65/adm"
 	"github.com/oracle/oci-go-sdk/v65/aianomalydetection"
 	"github.com/oracle/oci-go-sdk/v65/analytics"
@@ -40,6 +48,7 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/filestorage"
 	"github.com/oracle/oci-go-sdk/v65/functions"
 	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
 	"github.com/oracle/oci-go-sdk/v65/keymanagement"
 	"github.com/oracle/oci-go-sdk/v65/loadbalancer"
 	"github.com/oracle/oci-go-sdk/v65/logging"
@@ -58,7 +67,6 @@ import (
 	"github.com/oracle/oci-go-sdk/v65/streaming"
 	"github.com/oracle/oci-go-sdk/v65/vault"
 	"github.com/turbot/go-kit/types"
-	"github.com/turbot/steampipe-plugin-sdk/v5/connection"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 )
 
@@ -89,6 +97,7 @@ type session struct {
 	FileStorageClient                     filestorage.FileStorageClient
 	FunctionsManagementClient             functions.FunctionsManagementClient
 	IdentityClient                        identity.IdentityClient
+	IdentityDomainsClient                 identitydomains.IdentityDomainsClient
 	KmsManagementClient                   keymanagement.KmsManagementClient
 	KmsVaultClient                        keymanagement.KmsVaultClient
 	LoadBalancerClient                    loadbalancer.LoadBalancerClient
@@ -119,6 +128,11 @@ type session struct {
 func admService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("adm-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -128,7 +142,7 @@ func admService(ctx context.Context, d *plugin.QueryData, region string) (*sessi
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("admService", "getProvider.Error", err)
 		return nil, err
@@ -139,6 +153,11 @@ func admService(ctx context.Context, d *plugin.QueryData, region string) (*sessi
 	if err != nil {
 		return nil, err
 	}
+
+	if err := configureClient(ctx, d, &client.BaseClient, "adm", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -161,6 +180,11 @@ func admService(ctx context.Context, d *plugin.QueryData, region string) (*sessi
 func aiAnomalyDetectionService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("aianomalydetection-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -170,7 +194,7 @@ func aiAnomalyDetectionService(ctx context.Context, d *plugin.QueryData, region
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("aiAnomalyDetectionService", "getProvider.Error", err)
 		return nil, err
@@ -182,6 +206,10 @@ func aiAnomalyDetectionService(ctx context.Context, d *plugin.QueryData, region
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "aianomalydetection", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -203,6 +231,11 @@ func aiAnomalyDetectionService(ctx context.Context, d *plugin.QueryData, region
 func apiGatewayService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("apigateway-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -212,7 +245,7 @@ func apiGatewayService(ctx context.Context, d *plugin.QueryData, region string)
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("apiGatewayService", "error_getProvider", err)
 		return nil, err
@@ -225,6 +258,10 @@ func apiGatewayService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "apigateway", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -246,6 +283,11 @@ func apiGatewayService(ctx context.Context, d *plugin.QueryData, region string)
 func artifactService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("artifact-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -255,7 +297,7 @@ func artifactService(ctx context.Context, d *plugin.QueryData, region string) (*
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("artifactService", "error_getProvider", err)
 		return nil, err
@@ -268,6 +310,10 @@ func artifactService(ctx context.Context, d *plugin.QueryData, region string) (*
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "artifacts", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -287,9 +333,13 @@ func artifactService(ctx context.Context, d *plugin.QueryData, region string) (*
 }
 
 // auditService returns the service client for OCI Audit service
-func auditService(ctx context.Context, d *plugin.QueryData) (*session, error) {
+func auditService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
 
-	serviceCacheKey := fmt.Sprintf("audit-%s", "region")
+	serviceCacheKey := fmt.Sprintf("audit-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
 	}
@@ -297,7 +347,7 @@ func auditService(ctx context.Context, d *plugin.QueryData) (*session, error) {
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, "", ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -308,6 +358,10 @@ func auditService(ctx context.Context, d *plugin.QueryData) (*session, error) {
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "audit", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -329,6 +383,11 @@ func auditService(ctx context.Context, d *plugin.QueryData) (*session, error) {
 func autoScalingService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("autoscaling-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -338,7 +397,7 @@ func autoScalingService(ctx context.Context, d *plugin.QueryData, region string)
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("autoScalingService", "getProvider.Error", err)
 		return nil, err
@@ -349,6 +408,10 @@ func autoScalingService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "autoscaling", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -369,6 +432,11 @@ func autoScalingService(ctx context.Context, d *plugin.QueryData, region string)
 func bdsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("bigdata-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -378,7 +446,7 @@ func bdsService(ctx context.Context, d *plugin.QueryData, region string) (*sessi
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("bdsService", "getProvider.Error", err)
 		return nil, err
@@ -389,6 +457,10 @@ func bdsService(ctx context.Context, d *plugin.QueryData, region string) (*sessi
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "bds", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -407,6 +479,10 @@ func bdsService(ctx context.Context, d *plugin.QueryData, region string) (*sessi
 
 // identityService returns the service client for OCI Identity service
 func identityService(ctx context.Context, d *plugin.QueryData) (*session, error) {
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
 
 	serviceCacheKey := fmt.Sprintf("identity-%s", "region")
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -416,7 +492,7 @@ func identityService(ctx context.Context, d *plugin.QueryData) (*session, error)
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, "", ociConfig)
+	provider, err := getProvider(ctx, d, "", ociConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -427,6 +503,10 @@ func identityService(ctx context.Context, d *plugin.QueryData) (*session, error)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "identity", ""); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -448,6 +528,11 @@ func identityService(ctx context.Context, d *plugin.QueryData) (*session, error)
 func devOpsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("devops-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -457,7 +542,7 @@ func devOpsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("devOpsService", "getProvider.Error", err)
 		return nil, err
@@ -468,6 +553,10 @@ func devOpsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "devops", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -488,6 +577,11 @@ func devOpsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 func identityServiceRegional(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("identityregional-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -497,7 +591,7 @@ func identityServiceRegional(ctx context.Context, d *plugin.QueryData, region st
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("identityServiceRegional", "getProvider.Error", err)
 		return nil, err
@@ -508,6 +602,10 @@ func identityServiceRegional(ctx context.Context, d *plugin.QueryData, region st
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "identity", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -524,10 +622,68 @@ func identityServiceRegional(ctx context.Context, d *plugin.QueryData, region st
 	return sess, nil
 }
 
+// identityDomainsService returns the service client for the SCIM API of an OCI Identity Domain, called
+// on the URL of the domain
+func identityDomainsService(ctx context.Context, d *plugin.QueryData, endpoint string) (*session, error) {
+	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
+	// have we already created and cached the service?
+	serviceCacheKey := fmt.Sprintf("identitydomains-%s", endpoint)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
+
+	// get oci config info
+	ociConfig := GetConfig(d.Connection)
+
+	provider, err := getProvider(ctx, d, "", ociConfig)
+	if err != nil {
+		logger.Error("identityDomainsService", "getProvider.Error", err)
+		return nil, err
+	}
+
+	client, err := identitydomains.NewIdentityDomainsClientWithConfigurationProvider(provider, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := configureClient(ctx, d, &client.BaseClient, "identitydomains", ""); err != nil {
+		return nil, err
+	}
+
+	// the URL of the domain is the endpoint of its SCIM API, whatever the endpoint configuration
+	client.Host = endpoint
+
+	tenantId, err := provider.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+
+	sess := &session{
+		TenancyID:             tenantId,
+		IdentityDomainsClient: client,
+	}
+
+	// save session in cache
+	d.ConnectionManager.Cache.Set(serviceCacheKey, sess)
+
+	return sess, nil
+}
+
 // loggingManagementService returns the service client for OCI Logging Management Service
 func loggingManagementService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("loggingmanagement-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -537,7 +693,7 @@ func loggingManagementService(ctx context.Context, d *plugin.QueryData, region s
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("loggingManagementService", "getProvider.Error", err)
 		return nil, err
@@ -548,6 +704,10 @@ func loggingManagementService(ctx context.Context, d *plugin.QueryData, region s
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "logging", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -568,6 +728,11 @@ func loggingManagementService(ctx context.Context, d *plugin.QueryData, region s
 func loggingSearchService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("loggingsearch-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -577,7 +742,7 @@ func loggingSearchService(ctx context.Context, d *plugin.QueryData, region strin
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("loggingSearchService", "getProvider.Error", err)
 		return nil, err
@@ -588,6 +753,10 @@ func loggingSearchService(ctx context.Context, d *plugin.QueryData, region strin
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "loggingsearch", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -608,6 +777,11 @@ func loggingSearchService(ctx context.Context, d *plugin.QueryData, region strin
 func coreBlockStorageService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("blockstorage-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -617,7 +791,7 @@ func coreBlockStorageService(ctx context.Context, d *plugin.QueryData, region st
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("coreBlockStorageService", "getProvider.Error", err)
 		return nil, err
@@ -628,6 +802,10 @@ func coreBlockStorageService(ctx context.Context, d *plugin.QueryData, region st
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "blockstorage", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -648,6 +826,11 @@ func coreBlockStorageService(ctx context.Context, d *plugin.QueryData, region st
 func containerEngineService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("containerengine-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -657,7 +840,7 @@ func containerEngineService(ctx context.Context, d *plugin.QueryData, region str
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("containerEngineService", "getProvider.Error", err)
 		return nil, err
@@ -668,6 +851,10 @@ func containerEngineService(ctx context.Context, d *plugin.QueryData, region str
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "containerengine", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -688,6 +875,11 @@ func containerEngineService(ctx context.Context, d *plugin.QueryData, region str
 func eventsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("events-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -697,7 +889,7 @@ func eventsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("eventsService", "getProvider.Error", err)
 		return nil, err
@@ -708,6 +900,10 @@ func eventsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "events", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -728,6 +924,11 @@ func eventsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 func devopsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("devops-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -737,7 +938,7 @@ func devopsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("devopsService", "getProvider.Error", err)
 		return nil, err
@@ -748,6 +949,10 @@ func devopsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "devops", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -768,6 +973,11 @@ func devopsService(ctx context.Context, d *plugin.QueryData, region string) (*se
 func fileStorageService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("filestorage-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -777,7 +987,7 @@ func fileStorageService(ctx context.Context, d *plugin.QueryData, region string)
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("fileStorageService", "getProvider.Error", err)
 		return nil, err
@@ -787,6 +997,10 @@ func fileStorageService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "filestorage", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -806,6 +1020,12 @@ func fileStorageService(ctx context.Context, d *plugin.QueryData, region string)
 // functionsManagementService returns the service client for OCI Functions Management Service
 func functionsManagementService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("functionsmanagement-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -814,7 +1034,7 @@ func functionsManagementService(ctx context.Context, d *plugin.QueryData, region
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("functionsManagementService", "getProvider.Error", err)
 		return nil, err
@@ -825,6 +1045,10 @@ func functionsManagementService(ctx context.Context, d *plugin.QueryData, region
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "functions", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -845,6 +1069,11 @@ func functionsManagementService(ctx context.Context, d *plugin.QueryData, region
 func networkFirewallService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("networkFirewall-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -854,7 +1083,7 @@ func networkFirewallService(ctx context.Context, d *plugin.QueryData, region str
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("networkFirewallService", "getProvider.Error", err)
 		return nil, err
@@ -866,6 +1095,10 @@ func networkFirewallService(ctx context.Context, d *plugin.QueryData, region str
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "networkfirewall", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -887,6 +1120,11 @@ func networkFirewallService(ctx context.Context, d *plugin.QueryData, region str
 func kmsManagementService(ctx context.Context, d *plugin.QueryData, region string, endpoint string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// Cache the connection at vault level
 	serviceCacheKey := fmt.Sprintf("keymanagement-%s-%s", region, endpoint)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -894,7 +1132,7 @@ func kmsManagementService(ctx context.Context, d *plugin.QueryData, region strin
 	}
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("kmsManagementService", "getProvider.Error", err)
 		return nil, err
@@ -904,6 +1142,11 @@ func kmsManagementService(ctx context.Context, d *plugin.QueryData, region strin
 	if err != nil {
 		return nil, err
 	}
+
+	if err := configureClient(ctx, d, &client.BaseClient, "keymanagement", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -920,6 +1163,12 @@ func kmsManagementService(ctx context.Context, d *plugin.QueryData, region strin
 // kmsVaultService returns the service client for OCI KMS Vault Service
 func kmsVaultService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("vault-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -928,7 +1177,7 @@ func kmsVaultService(ctx context.Context, d *plugin.QueryData, region string) (*
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("kmsVaultService", "getProvider.Error", err)
 		return nil, err
@@ -939,6 +1188,10 @@ func kmsVaultService(ctx context.Context, d *plugin.QueryData, region string) (*
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "keymanagement", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -958,6 +1211,12 @@ func kmsVaultService(ctx context.Context, d *plugin.QueryData, region string) (*
 // loadBalancerService returns the service client for OCI Load Balancer Service
 func loadBalancerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("loadbalancer-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -966,7 +1225,7 @@ func loadBalancerService(ctx context.Context, d *plugin.QueryData, region string
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("loadBalancerService", "getProvider.Error", err)
 		return nil, err
@@ -977,6 +1236,10 @@ func loadBalancerService(ctx context.Context, d *plugin.QueryData, region string
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "loadbalancer", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -996,6 +1259,12 @@ func loadBalancerService(ctx context.Context, d *plugin.QueryData, region string
 // objectStorageService returns the service client for OCI Object Storage service
 func objectStorageService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("objectstorage-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1004,7 +1273,7 @@ func objectStorageService(ctx context.Context, d *plugin.QueryData, region strin
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("objectStorageService", "getProvider.Error", err)
 		return nil, err
@@ -1015,6 +1284,10 @@ func objectStorageService(ctx context.Context, d *plugin.QueryData, region strin
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "objectstorage", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1035,6 +1308,11 @@ func objectStorageService(ctx context.Context, d *plugin.QueryData, region strin
 func onsNotificationControlPlaneService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("notificationcontrolplane-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1044,7 +1322,7 @@ func onsNotificationControlPlaneService(ctx context.Context, d *plugin.QueryData
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("onsNotificationControlPlaneService", "getProvider.Error", err)
 		return nil, err
@@ -1056,6 +1334,10 @@ func onsNotificationControlPlaneService(ctx context.Context, d *plugin.QueryData
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "ons", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -1077,6 +1359,11 @@ func onsNotificationControlPlaneService(ctx context.Context, d *plugin.QueryData
 func onsNotificationDataPlaneService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("notificationdataplane-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1086,7 +1373,7 @@ func onsNotificationDataPlaneService(ctx context.Context, d *plugin.QueryData, r
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("notificationDataPlaneService", "getProvider.Error", err)
 		return nil, err
@@ -1098,6 +1385,10 @@ func onsNotificationDataPlaneService(ctx context.Context, d *plugin.QueryData, r
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "ons", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -1119,6 +1410,11 @@ func onsNotificationDataPlaneService(ctx context.Context, d *plugin.QueryData, r
 func coreComputeService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("computeregional-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1128,7 +1424,7 @@ func coreComputeService(ctx context.Context, d *plugin.QueryData, region string)
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("coreComputeServiceRegional", "getProvider.Error", err)
 		return nil, err
@@ -1140,6 +1436,10 @@ func coreComputeService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "compute", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -1161,6 +1461,11 @@ func coreComputeService(ctx context.Context, d *plugin.QueryData, region string)
 func coreComputeManagementService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("computemanagement-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1170,7 +1475,7 @@ func coreComputeManagementService(ctx context.Context, d *plugin.QueryData, regi
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("coreComputeManagementService", "getProvider.Error", err)
 		return nil, err
@@ -1182,6 +1487,10 @@ func coreComputeManagementService(ctx context.Context, d *plugin.QueryData, regi
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "compute", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -1202,6 +1511,12 @@ func coreComputeManagementService(ctx context.Context, d *plugin.QueryData, regi
 // coreVirtualNetworkService returns the service client for OCI Core VirtualNetwork Service
 func coreVirtualNetworkService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("virtualnetwork-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1210,7 +1525,7 @@ func coreVirtualNetworkService(ctx context.Context, d *plugin.QueryData, region
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("coreVirtualNetworkService", "getProvider.Error", err)
 		return nil, err
@@ -1221,6 +1536,10 @@ func coreVirtualNetworkService(ctx context.Context, d *plugin.QueryData, region
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "virtualnetwork", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1240,6 +1559,12 @@ func coreVirtualNetworkService(ctx context.Context, d *plugin.QueryData, region
 // cloudGuardService returns the service client for OCI Cloud Guard Service
 func cloudGuardService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("cloudguard-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1247,7 +1572,7 @@ func cloudGuardService(ctx context.Context, d *plugin.QueryData, region string)
 
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("cloudGuardService", "getProvider.Error", err)
 		return nil, err
@@ -1258,6 +1583,10 @@ func cloudGuardService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "cloudguard", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1277,6 +1606,12 @@ func cloudGuardService(ctx context.Context, d *plugin.QueryData, region string)
 // dnsService returns the service client for OCI DNS Service
 func dnsService(ctx context.Context, d *plugin.QueryData) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("dns-%s", "region")
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1285,7 +1620,7 @@ func dnsService(ctx context.Context, d *plugin.QueryData) (*session, error) {
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, "", ociConfig)
+	provider, err := getProvider(ctx, d, "", ociConfig)
 	if err != nil {
 		logger.Error("DNSService", "getProvider.Error", err)
 		return nil, err
@@ -1296,6 +1631,10 @@ func dnsService(ctx context.Context, d *plugin.QueryData) (*session, error) {
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "dns", ""); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1315,6 +1654,12 @@ func dnsService(ctx context.Context, d *plugin.QueryData) (*session, error) {
 // databaseService returns the service client for OCI Database Service
 func databaseService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("database-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1323,7 +1668,7 @@ func databaseService(ctx context.Context, d *plugin.QueryData, region string) (*
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("databaseService", "getProvider.Error", err)
 		return nil, err
@@ -1334,6 +1679,10 @@ func databaseService(ctx context.Context, d *plugin.QueryData, region string) (*
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "database", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1353,6 +1702,12 @@ func databaseService(ctx context.Context, d *plugin.QueryData, region string) (*
 // budgetService returns the service client for OCI budget Service
 func budgetService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("budget-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1361,7 +1716,7 @@ func budgetService(ctx context.Context, d *plugin.QueryData, region string) (*se
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("budgetService", "getProvider.Error", err)
 		return nil, err
@@ -1372,6 +1727,10 @@ func budgetService(ctx context.Context, d *plugin.QueryData, region string) (*se
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "budget", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1392,6 +1751,11 @@ func budgetService(ctx context.Context, d *plugin.QueryData, region string) (*se
 func certificatesManagementService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("certificatesmanagement-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1401,7 +1765,7 @@ func certificatesManagementService(ctx context.Context, d *plugin.QueryData, reg
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("certificatesManagementService", "getProvider.Error", err)
 		return nil, err
@@ -1413,6 +1777,10 @@ func certificatesManagementService(ctx context.Context, d *plugin.QueryData, reg
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "certificatesmanagement", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -1434,6 +1802,11 @@ func certificatesManagementService(ctx context.Context, d *plugin.QueryData, reg
 func certificatesService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("certificates-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1443,7 +1816,7 @@ func certificatesService(ctx context.Context, d *plugin.QueryData, region string
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("certificatesService", "getProvider.Error", err)
 		return nil, err
@@ -1455,6 +1828,10 @@ func certificatesService(ctx context.Context, d *plugin.QueryData, region string
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "certificates", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -1475,15 +1852,21 @@ func certificatesService(ctx context.Context, d *plugin.QueryData, region string
 // monitoringService returns the service client for OCI Monitoring Service
 func monitoringService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
-	serviceCacheKey := fmt.Sprintf("monitoring-%s", "region")
-	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
-		return cachedData.(*session), nil
-	}
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
+	serviceCacheKey := fmt.Sprintf("monitoring-%s", region)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
+		return cachedData.(*session), nil
+	}
 
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("monitoringService", "getProvider.Error", err)
 		return nil, err
@@ -1494,6 +1877,10 @@ func monitoringService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "monitoring", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1513,6 +1900,12 @@ func monitoringService(ctx context.Context, d *plugin.QueryData, region string)
 // mySQLChannelService returns the service client for OCI MySQL Channel Service
 func mySQLChannelService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("mysqlchannel-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1521,7 +1914,7 @@ func mySQLChannelService(ctx context.Context, d *plugin.QueryData, region string
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("mySQLChannelService", "getProvider.Error", err)
 		return nil, err
@@ -1532,6 +1925,10 @@ func mySQLChannelService(ctx context.Context, d *plugin.QueryData, region string
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "mysql", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1551,6 +1948,12 @@ func mySQLChannelService(ctx context.Context, d *plugin.QueryData, region string
 // mySqlDBSystemService returns the service client for OCI MySQL DbSystem Service
 func mySQLDBSystemService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("mysqldbsystem-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1559,7 +1962,7 @@ func mySQLDBSystemService(ctx context.Context, d *plugin.QueryData, region strin
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("mySQLDBSystem", "getProvider.Error", err)
 		return nil, err
@@ -1570,6 +1973,10 @@ func mySQLDBSystemService(ctx context.Context, d *plugin.QueryData, region strin
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "mysql", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1589,6 +1996,12 @@ func mySQLDBSystemService(ctx context.Context, d *plugin.QueryData, region strin
 // noSQLDatabaseService returns the service client for OCI NoSQL Database Service
 func noSQLDatabaseService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("nosql-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1597,7 +2010,7 @@ func noSQLDatabaseService(ctx context.Context, d *plugin.QueryData, region strin
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("noSQLDatabaseService", "getProvider.Error", err)
 		return nil, err
@@ -1608,6 +2021,10 @@ func noSQLDatabaseService(ctx context.Context, d *plugin.QueryData, region strin
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "nosql", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1627,6 +2044,12 @@ func noSQLDatabaseService(ctx context.Context, d *plugin.QueryData, region strin
 // mySQLBackupService returns the service client for OCI MySQL Backup Service
 func mySQLBackupService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("mysqlbackup-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1635,7 +2058,7 @@ func mySQLBackupService(ctx context.Context, d *plugin.QueryData, region string)
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("mySQLBackupService", "getProvider.Error", err)
 		return nil, err
@@ -1646,6 +2069,10 @@ func mySQLBackupService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "mysql", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1665,6 +2092,12 @@ func mySQLBackupService(ctx context.Context, d *plugin.QueryData, region string)
 // mySQLConfigurationService returns the service client for OCI MySQL Configuration Service
 func mySQLConfigurationService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("mysqlconfiguration-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1673,7 +2106,7 @@ func mySQLConfigurationService(ctx context.Context, d *plugin.QueryData, region
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("mySQLBackupService", "getProvider.Error", err)
 		return nil, err
@@ -1684,6 +2117,10 @@ func mySQLConfigurationService(ctx context.Context, d *plugin.QueryData, region
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "mysql", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1703,6 +2140,12 @@ func mySQLConfigurationService(ctx context.Context, d *plugin.QueryData, region
 // networkLoadBalancerService returns the service client for OCI Network Load Balancer service
 func networkLoadBalancerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("networkloadbalancer-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1711,7 +2154,7 @@ func networkLoadBalancerService(ctx context.Context, d *plugin.QueryData, region
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("networkLoadBalancerService", "getProvider.Error", err)
 		return nil, err
@@ -1722,6 +2165,10 @@ func networkLoadBalancerService(ctx context.Context, d *plugin.QueryData, region
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "networkloadbalancer", region); err != nil {
+		return nil, err
+	}
+
 	tenantID, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1741,6 +2188,12 @@ func networkLoadBalancerService(ctx context.Context, d *plugin.QueryData, region
 // queueService returns the service client for OCI Queue Service
 func queueService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("queue-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1749,7 +2202,7 @@ func queueService(ctx context.Context, d *plugin.QueryData, region string) (*ses
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("queueService", "getProvider.Error", err)
 		return nil, err
@@ -1760,6 +2213,10 @@ func queueService(ctx context.Context, d *plugin.QueryData, region string) (*ses
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "queue", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1779,6 +2236,12 @@ func queueService(ctx context.Context, d *plugin.QueryData, region string) (*ses
 // resourceSearchService returns the service client for OCI Resource Search Service
 func resourceSearchService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("resourcesearch-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1787,7 +2250,7 @@ func resourceSearchService(ctx context.Context, d *plugin.QueryData, region stri
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("resourceSearchService", "getProvider.Error", err)
 		return nil, err
@@ -1798,6 +2261,10 @@ func resourceSearchService(ctx context.Context, d *plugin.QueryData, region stri
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "resourcesearch", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1816,6 +2283,12 @@ func resourceSearchService(ctx context.Context, d *plugin.QueryData, region stri
 
 func resourceManagerService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("resourcemanager-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1824,7 +2297,7 @@ func resourceManagerService(ctx context.Context, d *plugin.QueryData, region str
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("resourceManagerService", "getProvider.Error", err)
 		return nil, err
@@ -1835,6 +2308,10 @@ func resourceManagerService(ctx context.Context, d *plugin.QueryData, region str
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "resourcemanager", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1854,6 +2331,12 @@ func resourceManagerService(ctx context.Context, d *plugin.QueryData, region str
 // streamAdminService returns the service client for OCI Stream Admin Service
 func streamAdminService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("streamadmin-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1862,7 +2345,7 @@ func streamAdminService(ctx context.Context, d *plugin.QueryData, region string)
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("resourceSearchService", "getProvider.Error", err)
 		return nil, err
@@ -1873,6 +2356,10 @@ func streamAdminService(ctx context.Context, d *plugin.QueryData, region string)
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "streaming", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1892,6 +2379,12 @@ func streamAdminService(ctx context.Context, d *plugin.QueryData, region string)
 // vaultService returns the service client for OCI Vault Service
 func vaultService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
+
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	serviceCacheKey := fmt.Sprintf("vaultService-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
 		return cachedData.(*session), nil
@@ -1900,7 +2393,7 @@ func vaultService(ctx context.Context, d *plugin.QueryData, region string) (*ses
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("vaultService", "getProvider.Error", err)
 		return nil, err
@@ -1911,6 +2404,10 @@ func vaultService(ctx context.Context, d *plugin.QueryData, region string) (*ses
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "vault", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -1931,6 +2428,11 @@ func vaultService(ctx context.Context, d *plugin.QueryData, region string) (*ses
 func analyticsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("analytics-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1940,7 +2442,7 @@ func analyticsService(ctx context.Context, d *plugin.QueryData, region string) (
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("analyticsService", "getProvider.Error", err)
 		return nil, err
@@ -1952,6 +2454,10 @@ func analyticsService(ctx context.Context, d *plugin.QueryData, region string) (
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "analytics", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -1973,6 +2479,11 @@ func analyticsService(ctx context.Context, d *plugin.QueryData, region string) (
 func bastionService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("bastion-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -1982,7 +2493,7 @@ func bastionService(ctx context.Context, d *plugin.QueryData, region string) (*s
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("bastionService", "getProvider.Error", err)
 		return nil, err
@@ -1994,6 +2505,10 @@ func bastionService(ctx context.Context, d *plugin.QueryData, region string) (*s
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "bastion", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -2015,6 +2530,11 @@ func bastionService(ctx context.Context, d *plugin.QueryData, region string) (*s
 func containerInstancesService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("containerinstances-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -2024,7 +2544,7 @@ func containerInstancesService(ctx context.Context, d *plugin.QueryData, region
 	// get oci config info
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("containerInstancesService", "getProvider.Error", err)
 		return nil, err
@@ -2035,6 +2555,10 @@ func containerInstancesService(ctx context.Context, d *plugin.QueryData, region
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "containerinstances", region); err != nil {
+		return nil, err
+	}
+
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
 		return nil, err
@@ -2055,6 +2579,11 @@ func containerInstancesService(ctx context.Context, d *plugin.QueryData, region
 func cloudMigrationsService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("cloudmigrations-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -2064,7 +2593,7 @@ func cloudMigrationsService(ctx context.Context, d *plugin.QueryData, region str
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("cloudMigrationsService", "error_getProvider", err)
 		return nil, err
@@ -2077,6 +2606,10 @@ func cloudMigrationsService(ctx context.Context, d *plugin.QueryData, region str
 		return nil, err
 	}
 
+	if err := configureClient(ctx, d, &client.BaseClient, "cloudmigrations", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
@@ -2095,21 +2628,119 @@ func cloudMigrationsService(ctx context.Context, d *plugin.QueryData, region str
 	return sess, nil
 }
 
-// get the configuration provider for the OCI plugin connection to intract with API's
-func getProvider(_ context.Context, d *connection.Manager, region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
+// configureClient applies the connection level settings shared by the clients of every service:
+// endpoint overrides, HTTP proxy, CA bundle and rate limits
+func configureClient(ctx context.Context, d *plugin.QueryData, client *oci_common.BaseClient, service string, region string) error {
+	config := GetConfig(d.Connection)
+	if region == "" {
+		region = getDefaultRegion(config)
+	}
 
-	cacheKey := "getProvider"
-	// if provider is already cached, return it
-	if cachedData, ok := d.Cache.Get(cacheKey); ok {
-		return cachedData.(oci_common.ConfigurationProvider), nil
+	host, err := getServiceEndpoint(client.Host, service, region, config)
+	if err != nil {
+		plugin.Logger(ctx).Error("configureClient", "service", service, "getServiceEndpoint.Error", err)
+		return err
 	}
+	client.Host = host
 
-	if region == "" && config.Regions != nil && len(config.Regions) > 0 {
-		region = config.Regions[0]
+	httpClient, err := getHttpClient(d, config)
+	if err != nil {
+		plugin.Logger(ctx).Error("configureClient", "service", service, "getHttpClient.Error", err)
+		return err
+	}
+	if httpClient != nil {
+		client.HTTPClient = httpClient
+	}
+
+	if limiter := getConnectionRateLimiter(d, service, region); limiter != nil {
+		client.Interceptor = rateLimitInterceptor(limiter, client.Interceptor)
+	}
+
+	return nil
+}
+
+// getServiceEndpoint returns the host a service client should call. The `endpoints` config argument
+// maps service names to endpoint templates, e.g. "https://iaas.{region}.{secondLevelDomain}", and
+// `realm_domain` replaces the second level domain of the endpoints, e.g. "oraclecloud.com".
+// Regions unknown to the SDK get the realm domain declared for them in `dedicated_regions`.
+func getServiceEndpoint(host string, service string, region string, config ociConfig) (string, error) {
+	template, hasTemplate := config.Endpoints[service]
+	realmDomain := getRealmDomain(region, config)
+	if !hasTemplate && realmDomain == "" {
+		return host, nil
+	}
+
+	// the second level domain the SDK uses for the region, e.g. "oraclecloud.com". It is only the end of
+	// the host, which may have more labels after the region, e.g. "identity.us-ashburn-1.oci.oraclecloud.com".
+	// Regions with dots are fully qualified and have no second level domain.
+	sdkDomain := ""
+	if !strings.Contains(region, ".") {
+		sdkDomain = oci_common.Region(region).EndpointForTemplate("", "{secondLevelDomain}")
+	}
+	domain := sdkDomain
+	if realmDomain != "" {
+		domain = realmDomain
 	}
 
+	if hasTemplate {
+		if strings.Contains(template, "{secondLevelDomain}") && domain == "" {
+			return "", fmt.Errorf("endpoint template %q of service %s requires the realm_domain config argument", template, service)
+		}
+		endpoint := strings.NewReplacer("{region}", region, "{secondLevelDomain}", domain).Replace(template)
+		if _, err := url.Parse(endpoint); err != nil {
+			return "", fmt.Errorf("invalid endpoint %q for service %s: %v", endpoint, service, err)
+		}
+		return endpoint, nil
+	}
+
+	// only replace the second level domain, keeping the labels before it
+	if sdkDomain != "" {
+		if index := strings.LastIndex(host, "."+sdkDomain); index >= 0 {
+			return host[:index+1] + domain + host[index+1+len(sdkDomain):], nil
+		}
+	}
+	return host, nil
+}
+
+// getRealmDomain returns the domain of the realm of a region set in the connection config, if any.
+// The SDK only knows the realms of the regions bundled with it, and falls back to the commercial
+// realm domain for the others, e.g. new dedicated regions.
+func getRealmDomain(region string, config ociConfig) string {
+	if config.RealmDomain != nil {
+		return strings.Trim(*config.RealmDomain, ".")
+	}
+	if domain, ok := config.DedicatedRegions[region]; ok {
+		return strings.Trim(domain, ".")
+	}
+	return ""
+}
+
+// getHttpClient returns the HTTP client shared by the service clients of the connection, if the
+// proxy_url, ca_bundle_path or http_recording_mode config arguments require one. Otherwise the SDK
+// default client is used.
+func getHttpClient(d *plugin.QueryData, config ociConfig) (*http.Client, error) {
+	if config.ProxyUrl == nil && config.CaBundlePath == nil && config.HttpRecordingMode == nil {
+		return nil, nil
+	}
+
+	cacheKey := "getHttpClient"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*http.Client), nil
+	}
+
+	httpClient, err := buildHttpClient(config, false)
+	if err != nil {
+		return nil, err
+	}
+	d.ConnectionManager.Cache.Set(cacheKey, httpClient)
+
+	return httpClient, nil
+}
+
+// get the configuration provider for the OCI plugin connection to intract with API's
+func getProvider(ctx context.Context, d *plugin.QueryData, region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
 	if region == "" {
-		region = getRegionFromEnvVar()
+		region = getDefaultRegion(config)
 	}
 
 	authType := "ApiKey"
@@ -2117,26 +2748,36 @@ func getProvider(_ context.Context, d *connection.Manager, region string, config
 		authType = *config.Auth
 	}
 
-	if authType == "SecurityToken" {
-		return getProviderForSecurityToken(region, config)
-	}
-
-	if authType == "InstancePrincipal" {
-		return getProviderForInstancePrincipal(region)
+	// providers are cached per auth type and region, since the region is baked into the provider
+	cacheKey := fmt.Sprintf("getProvider-%s-%s", authType, region)
+	// if provider is already cached, return it
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(oci_common.ConfigurationProvider), nil
 	}
 
-	if authType == "ApiKey" {
-		return getProviderForAPIkey(region, config)
+	var provider oci_common.ConfigurationProvider
+	var err error
+	switch authType {
+	case "SecurityToken":
+		provider, err = getProviderForSecurityToken(ctx, region, config)
+	case "InstancePrincipal":
+		provider, err = getProviderForInstancePrincipal(region, config)
+	case "ResourcePrincipal":
+		provider, err = getProviderForResourcePrincipal(region)
+	case "OkeWorkloadIdentity":
+		provider, err = getProviderForOkeWorkloadIdentity(region)
+	case "ApiKey":
+		provider, err = getProviderForAPIkey(region, config)
+	default:
+		regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
+		provider, err = oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, oci_common.DefaultConfigProvider()})
 	}
-
-	regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
-	provider, err := oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, oci_common.DefaultConfigProvider()})
 	if err != nil {
 		return nil, err
 	}
 
 	// save provider in cache
-	d.Cache.Set(cacheKey, provider)
+	d.ConnectionManager.Cache.Set(cacheKey, provider)
 
 	return provider, nil
 }
@@ -2220,27 +2861,192 @@ func getProviderForAPIkey(region string, config ociConfig) (oci_common.Configura
 		config_file_profile= "config_file_profile"
 	}
 */
-func getProviderForSecurityToken(region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
-	regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
-
+func getProviderForSecurityToken(ctx context.Context, region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
 	if config.Profile == nil {
 		return nil, fmt.Errorf("\n\n'config_file_profile'must be set in the connection configuration for 'SecurityToken' authentication. Edit your connection configuration file and then restart Steampipe")
 	}
 
 	profileString := *config.Profile
-	defaultPath := path.Join(getHomeFolder(), ".oci", "config")
-	if err := checkProfile(profileString, defaultPath); err != nil {
+	configPath := path.Join(getHomeFolder(), ".oci", "config")
+	if config.ConfigPath != nil && *config.ConfigPath != "" {
+		configPath = expandPath(*config.ConfigPath)
+	}
+	if err := checkProfile(profileString, configPath); err != nil {
+		return nil, err
+	}
+
+	// the tenancy, user, fingerprint and region are resolved by the SDK, which falls back to the DEFAULT
+	// profile and the TF_VAR_* environment variables for the settings missing from the profile
+	regionInfo := oci_common.NewRawConfigurationProvider("", "", region, "", "", nil)
+	base, err := oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, oci_common.CustomProfileConfigProvider(configPath, profileString)})
+	if err != nil {
+		return nil, err
+	}
+
+	provider := &securityTokenConfigurationProvider{
+		ConfigurationProvider: base,
+		logger:                plugin.Logger(ctx),
+		configPath:            configPath,
+		profile:               profileString,
+	}
+
+	// validate the token upfront, so that a missing or expired session is reported when the connection is used
+	// rather than as a signing failure on the first API call
+	if _, err := provider.KeyID(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// securityTokenConfigurationProvider signs requests with the session token created by
+// `oci session authenticate`. The SDK file provider caches the session key for the life of the
+// process, so the token and the key are loaded here instead, and loaded again when the token file
+// changes, so that a session refreshed with `oci session refresh` or re-created with
+// `oci session authenticate` is picked up without restarting the plugin.
+type securityTokenConfigurationProvider struct {
+	oci_common.ConfigurationProvider
+	logger     hclog.Logger
+	configPath string
+	profile    string
+
+	mu      sync.Mutex
+	session *securityTokenSession
+}
+
+// securityTokenSession is a snapshot of a session, loaded with a single read of the config file
+type securityTokenSession struct {
+	tokenPath    string
+	tokenModTime time.Time
+	keyID        string
+	privateKey   *rsa.PrivateKey
+	expiresAt    time.Time
+}
+
+// currentSession returns the loaded session, or loads it again if the token file changed or the token expired
+func (p *securityTokenConfigurationProvider) currentSession() (*securityTokenSession, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.session != nil && time.Now().Before(p.session.expiresAt) {
+		if info, err := os.Stat(p.session.tokenPath); err == nil && info.ModTime().Equal(p.session.tokenModTime) {
+			return p.session, nil
+		}
+	}
+
+	session, err := p.loadSession()
+	if err != nil {
+		return nil, err
+	}
+	p.session = session
+	p.logger.Trace("securityTokenConfigurationProvider.currentSession", "profile", p.profile, "expires_at", session.expiresAt.Format(time.RFC3339))
+
+	return session, nil
+}
+
+func (p *securityTokenConfigurationProvider) loadSession() (*securityTokenSession, error) {
+	settings, err := readProfileSettings(p.profile, p.configPath)
+	if err != nil {
+		return nil, err
+	}
+	if settings["security_token_file"] == "" {
+		return nil, fmt.Errorf("\n\nprofile '%s' in '%s' does not contain a 'security_token_file'. Run 'oci session authenticate --profile-name %s' and then restart Steampipe", p.profile, p.configPath, p.profile)
+	}
+
+	tokenPath := expandPath(settings["security_token_file"])
+	info, err := os.Stat(tokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("\n\ncan not read security token for profile '%s' from '%s': %v", p.profile, tokenPath, err)
+	}
+	data, err := os.ReadFile(tokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("\n\ncan not read security token for profile '%s' from '%s': %v", p.profile, tokenPath, err)
+	}
+	token := strings.TrimSpace(string(data))
+
+	expiresAt, err := getSecurityTokenExpiry(token)
+	if err != nil {
+		return nil, fmt.Errorf("\n\nsecurity token for profile '%s' is invalid: %v", p.profile, err)
+	}
+	if time.Now().After(expiresAt) {
+		return nil, fmt.Errorf("\n\nsecurity token for profile '%s' expired at %s. Run 'oci session refresh --profile %s' or 'oci session authenticate' to create a new session", p.profile, expiresAt.Format(time.RFC3339), p.profile)
+	}
+
+	keyFile := settings["key_file"]
+	if keyFile == "" {
+		keyFile = os.Getenv("TF_VAR_private_key_path")
+	}
+	if keyFile == "" {
+		return nil, fmt.Errorf("profile '%s' in '%s' does not contain a 'key_file'", p.profile, p.configPath)
+	}
+	keyPath := expandPath(keyFile)
+	pemFileContent, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("can not read private key from: '%s', Error: %q", keyPath, err)
+	}
+	passphrase := settings["pass_phrase"]
+	if passphrase == "" {
+		passphrase = settings["passphrase"]
+	}
+	privateKey, err := oci_common.PrivateKeyFromBytes(pemFileContent, &passphrase)
+	if err != nil {
 		return nil, err
 	}
 
-	securityTokenBasedAuthConfigProvider := oci_common.CustomProfileConfigProvider(defaultPath, profileString)
+	return &securityTokenSession{
+		tokenPath:    tokenPath,
+		tokenModTime: info.ModTime(),
+		keyID:        "ST$" + token,
+		privateKey:   privateKey,
+		expiresAt:    expiresAt,
+	}, nil
+}
+
+func (p *securityTokenConfigurationProvider) KeyID() (string, error) {
+	session, err := p.currentSession()
+	if err != nil {
+		return "", err
+	}
+	return session.keyID, nil
+}
 
-	keyId, err := securityTokenBasedAuthConfigProvider.KeyID()
-	if err != nil || !strings.HasPrefix(keyId, "ST$") {
-		return nil, fmt.Errorf("security token is invalid")
+func (p *securityTokenConfigurationProvider) PrivateRSAKey() (*rsa.PrivateKey, error) {
+	session, err := p.currentSession()
+	if err != nil {
+		return nil, err
 	}
+	return session.privateKey, nil
+}
+
+func (p *securityTokenConfigurationProvider) AuthType() (oci_common.AuthConfig, error) {
+	return oci_common.AuthConfig{AuthType: oci_common.UserPrincipal, IsFromConfigFile: true}, nil
+}
 
-	return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{regionInfo, securityTokenBasedAuthConfigProvider})
+// Refreshable lets the SDK retry requests rejected with a 401, which loads the session again if the token file changed
+func (p *securityTokenConfigurationProvider) Refreshable() bool {
+	return true
+}
+
+// Returns the expiry time from the "exp" claim of a session token
+func getSecurityTokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can not decode token payload: %v", err)
+	}
+	claims := struct {
+		Exp int64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("can not parse token claims: %v", err)
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, fmt.Errorf("token does not have an expiry time")
+	}
+	return time.Unix(claims.Exp, 0), nil
 }
 
 /*
@@ -2252,18 +3058,20 @@ func getProviderForSecurityToken(region string, config ociConfig) (oci_common.Co
 		region 		= [ "ap-mumbai-1" ]
 	}
 */
-func getProviderForInstancePrincipal(region string) (oci_common.ConfigurationProvider, error) {
+func getProviderForInstancePrincipal(region string, config ociConfig) (oci_common.ConfigurationProvider, error) {
 
 	// Used to modify InstancePrincipal auth clients so that `accept_local_certs` is honored for auth clients as well
 	// These clients are created implicitly by SDK, and are not modified by the buildConfigureClientFn that usually does this for the other SDK clients
+	// The proxy_url and ca_bundle_path config arguments are applied to these clients as well
 	instancePrincipalAuthClientModifier := func(client oci_common.HTTPRequestDispatcher) (oci_common.HTTPRequestDispatcher, error) {
 		if acceptLocalCerts := getEnvSettingWithBlankDefault("accept_local_certs"); acceptLocalCerts != "" {
 			if bool, err := strconv.ParseBool(acceptLocalCerts); err == nil {
-				modifiedClient := buildHttpClient()
-				modifiedClient.Transport.(*http.Transport).TLSClientConfig.InsecureSkipVerify = bool
-				return modifiedClient, nil
+				return buildHttpClient(config, bool)
 			}
 		}
+		if config.ProxyUrl != nil || config.CaBundlePath != nil {
+			return buildHttpClient(config, false)
+		}
 		return client, nil
 	}
 
@@ -2275,6 +3083,120 @@ func getProviderForInstancePrincipal(region string) (oci_common.ConfigurationPro
 	return oci_common.ComposingConfigurationProvider([]oci_common.ConfigurationProvider{cfg})
 }
 
+/*
+# Provider for Resource Principal based authentication
+
+	connection "oci" {
+		plugin = "oci"
+		auth   = "ResourcePrincipal"
+	}
+*/
+func getProviderForResourcePrincipal(region string) (oci_common.ConfigurationProvider, error) {
+	version, ok := os.LookupEnv(oci_common_auth.ResourcePrincipalVersionEnvVar)
+	if !ok {
+		return nil, missingAuthEnvVarError("ResourcePrincipal", oci_common_auth.ResourcePrincipalVersionEnvVar)
+	}
+
+	// Resource principal v2.2 (OCI Functions, Data Science jobs) hands the session token and the
+	// private key to the workload through environment variables which may hold either the value or a path
+	if version == oci_common_auth.ResourcePrincipalVersion2_2 {
+		for _, envVar := range []string{oci_common_auth.ResourcePrincipalRPSTEnvVar, oci_common_auth.ResourcePrincipalPrivatePEMEnvVar} {
+			value, ok := os.LookupEnv(envVar)
+			if !ok || value == "" {
+				return nil, missingAuthEnvVarError("ResourcePrincipal", envVar)
+			}
+			if path.IsAbs(value) {
+				if _, err := os.Stat(value); err != nil {
+					return nil, fmt.Errorf("\n\n'ResourcePrincipal' authentication could not read the file '%s' referenced by the %s environment variable: %v", value, envVar, err)
+				}
+			}
+		}
+	}
+
+	if region == "" {
+		region = os.Getenv(oci_common_auth.ResourcePrincipalRegionEnvVar)
+	}
+
+	var provider oci_common_auth.ConfigurationProviderWithClaimAccess
+	var err error
+	if version == oci_common_auth.ResourcePrincipalVersion2_2 && region != "" {
+		provider, err = oci_common_auth.ResourcePrincipalConfigurationProviderForRegion(oci_common.StringToRegion(region))
+	} else {
+		provider, err = oci_common_auth.ResourcePrincipalConfigurationProvider()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("\n\n'ResourcePrincipal' authentication failed: %v", err)
+	}
+
+	return withProviderRegion(provider, region), nil
+}
+
+/*
+# Provider for OKE Workload Identity based authentication
+
+	connection "oci" {
+		plugin = "oci"
+		auth   = "OkeWorkloadIdentity"
+	}
+*/
+func getProviderForOkeWorkloadIdentity(region string) (oci_common.ConfigurationProvider, error) {
+	for _, envVar := range []string{oci_common_auth.ResourcePrincipalVersionEnvVar, oci_common_auth.ResourcePrincipalRegionEnvVar, oci_common_auth.KubernetesServiceHostEnvVar} {
+		if value, ok := os.LookupEnv(envVar); !ok || value == "" {
+			return nil, missingAuthEnvVarError("OkeWorkloadIdentity", envVar)
+		}
+	}
+
+	// The service account token is projected into the pod by Kubernetes and is re-read by the SDK on every refresh
+	if _, err := os.Stat(oci_common_auth.KubernetesServiceAccountTokenPath); err != nil {
+		return nil, fmt.Errorf("\n\n'OkeWorkloadIdentity' authentication could not read the Kubernetes service account token at '%s': %v. Make sure the pod runs with a service account token mounted", oci_common_auth.KubernetesServiceAccountTokenPath, err)
+	}
+
+	certPath := oci_common_auth.DefaultKubernetesServiceAccountCertPath
+	if value, ok := os.LookupEnv(oci_common_auth.OciKubernetesServiceAccountCertPath); ok && value != "" {
+		certPath = value
+	}
+	if _, err := os.Stat(certPath); err != nil {
+		return nil, fmt.Errorf("\n\n'OkeWorkloadIdentity' authentication could not read the Kubernetes service account CA certificate at '%s': %v", certPath, err)
+	}
+
+	provider, err := oci_common_auth.OkeWorkloadIdentityConfigurationProvider()
+	if err != nil {
+		return nil, fmt.Errorf("\n\n'OkeWorkloadIdentity' authentication failed: %v", err)
+	}
+
+	return withProviderRegion(provider, region), nil
+}
+
+// regionalClaimAccessProvider pins a resource principal based provider to a region other than the one
+// advertised by the environment. The wrapped provider is kept as is, so that the SDK still refreshes
+// the session token when it expires.
+type regionalClaimAccessProvider struct {
+	oci_common_auth.ConfigurationProviderWithClaimAccess
+	region string
+}
+
+func (p regionalClaimAccessProvider) Region() (string, error) {
+	return p.region, nil
+}
+
+func (p regionalClaimAccessProvider) Refreshable() bool {
+	if refreshable, ok := p.ConfigurationProviderWithClaimAccess.(oci_common.RefreshableConfigurationProvider); ok {
+		return refreshable.Refreshable()
+	}
+	return false
+}
+
+func withProviderRegion(provider oci_common_auth.ConfigurationProviderWithClaimAccess, region string) oci_common.ConfigurationProvider {
+	if providerRegion, err := provider.Region(); region == "" || (err == nil && providerRegion == region) {
+		return provider
+	}
+	return regionalClaimAccessProvider{provider, region}
+}
+
+func missingAuthEnvVarError(authType string, envVar string) error {
+	return fmt.Errorf("\n\n'%s' authentication requires the %s environment variable to be set. Make sure Steampipe is running inside a workload that provides it", authType, envVar)
+}
+
 // cleans and expands the path if it contains a tilde,
 // returns the expanded path or the input path as is if not expansion was performed
 func expandPath(filepath string) string {
@@ -2299,20 +3221,52 @@ func getHomeFolder() string {
 
 // Check for the profile in config file
 func checkProfile(profile string, path string) (err error) {
+	_, err = readProfileSettings(profile, path)
+	return err
+}
+
+// Read the key/value settings of a profile in the OCI config file. As in the SDK, the settings missing
+// from the profile are taken from the DEFAULT profile.
+func readProfileSettings(profile string, path string) (map[string]string, error) {
 	var profileRegex = regexp.MustCompile(`^\[(.*)\]`)
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	content := string(data)
 	splitContent := strings.Split(content, "\n")
+
+	profiles := map[string]map[string]string{}
+	var settings map[string]string
 	for _, line := range splitContent {
-		if match := profileRegex.FindStringSubmatch(line); len(match) > 1 && match[1] == profile {
-			return nil
+		line = strings.TrimSpace(line)
+		if match := profileRegex.FindStringSubmatch(line); len(match) > 1 {
+			if profiles[match[1]] == nil {
+				profiles[match[1]] = map[string]string{}
+			}
+			settings = profiles[match[1]]
+			continue
+		}
+		if settings == nil || line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if key, value, ok := strings.Cut(line, "="); ok {
+			settings[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
 		}
 	}
 
-	return fmt.Errorf("configuration file did not contain profile: %s", profile)
+	if profiles[profile] == nil {
+		return nil, fmt.Errorf("configuration file did not contain profile: %s", profile)
+	}
+
+	merged := map[string]string{}
+	for key, value := range profiles["DEFAULT"] {
+		merged[key] = value
+	}
+	for key, value := range profiles[profile] {
+		merged[key] = value
+	}
+	return merged, nil
 }
 
 // Get the value of environment variables
@@ -2400,25 +3354,84 @@ func getProviderFromCLIEnvironmentVariables() (oci_common.ConfigurationProvider,
 	return nil, nil
 }
 
-func buildHttpClient() (httpClient *http.Client) {
-	httpClient = &http.Client{
-		Timeout: 0,
-		Transport: &http.Transport{
-			DialContext: (&net.Dialer{
-				Timeout: 10000000000, // 10s
-			}).DialContext,
-			TLSHandshakeTimeout: 10000000000, // 10s
-			TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12},
-			Proxy:               http.ProxyFromEnvironment,
-		},
+// buildHttpClient returns an HTTP client honoring the proxy_url, ca_bundle_path and http_recording_mode
+// config arguments. insecureSkipVerify disables the verification of the server certificates.
+func buildHttpClient(config ociConfig, insecureSkipVerify bool) (*http.Client, error) {
+	transport := &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 10000000000, // 10s
+		}).DialContext,
+		TLSHandshakeTimeout: 10000000000, // 10s
+		TLSClientConfig:     &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: insecureSkipVerify},
+		Proxy:               http.ProxyFromEnvironment,
 	}
-	return
+
+	// Route the requests through the configured proxy instead of the HTTPS_PROXY environment variable
+	if config.ProxyUrl != nil {
+		proxyUrl, err := url.Parse(*config.ProxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url %q: %v", *config.ProxyUrl, err)
+		}
+		transport.Proxy = func(request *http.Request) (*url.URL, error) {
+			// the instance metadata service and local servers are never reached through the proxy
+			if ip := net.ParseIP(request.URL.Hostname()); ip != nil && (ip.IsLoopback() || ip.IsLinkLocalUnicast()) {
+				return nil, nil
+			}
+			if request.URL.Hostname() == "localhost" {
+				return nil, nil
+			}
+			return proxyUrl, nil
+		}
+	}
+
+	// Trust the certificates of the bundle in addition to the system ones, e.g. for proxies doing TLS interception
+	if config.CaBundlePath != nil {
+		caBundlePath := expandPath(*config.CaBundlePath)
+		pem, err := os.ReadFile(caBundlePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_bundle_path %s: %v", caBundlePath, err)
+		}
+		certPool, err := x509.SystemCertPool()
+		if err != nil || certPool == nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in ca_bundle_path %s", caBundlePath)
+		}
+		transport.TLSClientConfig.RootCAs = certPool
+	}
+
+	// Record the API responses to fixture files, or replay them without calling OCI
+	if config.HttpRecordingMode != nil {
+		recordingDir := ""
+		if config.HttpRecordingDir != nil {
+			recordingDir = expandPath(*config.HttpRecordingDir)
+		}
+		recorder, err := newRecordingTransport(*config.HttpRecordingMode, recordingDir, transport)
+		if err != nil {
+			return nil, err
+		}
+		return &http.Client{
+			Timeout:   0,
+			Transport: recorder,
+		}, nil
+	}
+
+	return &http.Client{
+		Timeout:   0,
+		Transport: transport,
+	}, nil
 }
 
 // serviceCatalogService returns the service client for OCI Service Catalog service
 func serviceCatalogService(ctx context.Context, d *plugin.QueryData, region string) (*session, error) {
 	logger := plugin.Logger(ctx)
 
+	// return the error recorded while building the query matrix, if any
+	if err := getMatrixItemError(d); err != nil {
+		return nil, err
+	}
+
 	// have we already created and cached the service?
 	serviceCacheKey := fmt.Sprintf("servicecatalog-%s", region)
 	if cachedData, ok := d.ConnectionManager.Cache.Get(serviceCacheKey); ok {
@@ -2428,7 +3441,7 @@ func serviceCatalogService(ctx context.Context, d *plugin.QueryData, region stri
 	// get oci config info from steampipe connection
 	ociConfig := GetConfig(d.Connection)
 
-	provider, err := getProvider(ctx, d.ConnectionManager, region, ociConfig)
+	provider, err := getProvider(ctx, d, region, ociConfig)
 	if err != nil {
 		logger.Error("serviceCatalogService", "getProvider.Error", err)
 		return nil, err
@@ -2443,6 +3456,10 @@ func serviceCatalogService(ctx context.Context, d *plugin.QueryData, region stri
 	// set the region
 	client.SetRegion(region)
 
+	if err := configureClient(ctx, d, &client.BaseClient, "servicecatalog", region); err != nil {
+		return nil, err
+	}
+
 	// get tenant ocid from provider
 	tenantId, err := provider.TenancyOCID()
 	if err != nil {
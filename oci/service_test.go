@@ -0,0 +1,614 @@
+package oci
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/context_key"
+)
+
+const (
+	testTenancy     = "ocid1.tenancy.oc1..testtenancy"
+	testUser        = "ocid1.user.oc1..testuser"
+	testFingerprint = "11:22:33:44:55:66:77:88:99:aa:bb:cc:dd:ee:ff:00"
+	testRegion      = "us-ashburn-1"
+)
+
+// signatureServer is a fake OCI API, which accepts the requests signed with one of its keys
+type signatureServer struct {
+	*httptest.Server
+	keys map[string]*rsa.PublicKey
+
+	mu     sync.Mutex
+	keyIDs []string
+}
+
+func newSignatureServer(t *testing.T, keys map[string]*rsa.PublicKey) *signatureServer {
+	s := &signatureServer{keys: keys}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		keyID, err := verifyRequestSignature(r, s.keys)
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"code": "NotAuthenticated", "message": err.Error()})
+			return
+		}
+		s.mu.Lock()
+		s.keyIDs = append(s.keyIDs, keyID)
+		s.mu.Unlock()
+		io.WriteString(w, `{"id":"`+testTenancy+`"}`)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// verifyRequestSignature checks the Authorization header of a request as OCI does, and returns the key id
+// the request is signed with
+func verifyRequestSignature(r *http.Request, keys map[string]*rsa.PublicKey) (string, error) {
+	authorization, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Signature ")
+	if !ok {
+		return "", fmt.Errorf("request is not signed")
+	}
+	params := map[string]string{}
+	for _, param := range strings.Split(authorization, ",") {
+		key, value, _ := strings.Cut(param, "=")
+		params[key] = strings.Trim(value, `"`)
+	}
+	if params["version"] != "1" || params["algorithm"] != "rsa-sha256" {
+		return "", fmt.Errorf("unsupported signature version %q or algorithm %q", params["version"], params["algorithm"])
+	}
+	key, ok := keys[params["keyId"]]
+	if !ok {
+		return "", fmt.Errorf("unknown key id %q", params["keyId"])
+	}
+
+	headers := strings.Fields(params["headers"])
+	required := []string{"date", "(request-target)", "host"}
+	if r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch {
+		required = append(required, "content-length", "content-type", "x-content-sha256")
+	}
+	for _, header := range required {
+		if !slices.Contains(headers, header) {
+			return "", fmt.Errorf("header %q is not signed", header)
+		}
+	}
+
+	date, err := http.ParseTime(r.Header.Get("Date"))
+	if err != nil || time.Since(date).Abs() > 5*time.Minute {
+		return "", fmt.Errorf("invalid date %q", r.Header.Get("Date"))
+	}
+
+	if slices.Contains(headers, "x-content-sha256") {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return "", err
+		}
+		hash := sha256.Sum256(body)
+		if r.Header.Get("X-Content-Sha256") != base64.StdEncoding.EncodeToString(hash[:]) {
+			return "", fmt.Errorf("body hash %q does not match the body", r.Header.Get("X-Content-Sha256"))
+		}
+		if r.Header.Get("Content-Length") != strconv.Itoa(len(body)) {
+			return "", fmt.Errorf("content length %q does not match the body", r.Header.Get("Content-Length"))
+		}
+	}
+
+	lines := make([]string, 0, len(headers))
+	for _, header := range headers {
+		switch header {
+		case "(request-target)":
+			lines = append(lines, header+": "+strings.ToLower(r.Method)+" "+r.URL.RequestURI())
+		case "host":
+			lines = append(lines, header+": "+r.Host)
+		default:
+			lines = append(lines, header+": "+r.Header.Get(header))
+		}
+	}
+	signature, err := base64.StdEncoding.DecodeString(params["signature"])
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return "", fmt.Errorf("invalid signature for key id %q", params["keyId"])
+	}
+
+	return params["keyId"], nil
+}
+
+// callSignatureServer sends a GET and a POST request with a body to the server, signed with the credentials
+// of a provider
+func callSignatureServer(server *signatureServer, provider common.ConfigurationProvider) error {
+	client, err := identity.NewIdentityClientWithConfigurationProvider(provider)
+	if err != nil {
+		return err
+	}
+	client.Host = server.URL
+
+	ctx := context.Background()
+	if _, err := client.GetTenancy(ctx, identity.GetTenancyRequest{TenancyId: types.String(testTenancy)}); err != nil {
+		return err
+	}
+	_, err = client.CreateCompartment(ctx, identity.CreateCompartmentRequest{
+		CreateCompartmentDetails: identity.CreateCompartmentDetails{
+			CompartmentId: types.String(testTenancy),
+			Name:          types.String("test"),
+			Description:   types.String("compartment created by the signature tests"),
+		},
+	})
+	return err
+}
+
+// testKey returns a new private key, and writes it to a PEM file, encrypted if a passphrase is given
+func testKey(t *testing.T, passphrase string) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if passphrase != "" {
+		//lint:ignore SA1019 the OCI CLI writes legacy encrypted PEM keys, which the SDK reads
+		block, err = x509.EncryptPEMBlock(rand.Reader, block.Type, block.Bytes, []byte(passphrase), x509.PEMCipherAES256)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return key, writeTestFile(t, "key.pem", string(pem.EncodeToMemory(block)))
+}
+
+func writeTestFile(t *testing.T, name string, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// testSecurityToken returns a session token expiring at the given time
+func testSecurityToken(expiresAt time.Time) string {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+	return encode(`{"alg":"RS256","typ":"JWT"}`) + "." + encode(fmt.Sprintf(`{"exp":%d}`, expiresAt.Unix())) + "." + encode("signature")
+}
+
+// isolateAuthEnv clears the environment variables read by the auth providers
+func isolateAuthEnv(t *testing.T) {
+	for _, name := range []string{"tenancy_ocid", "user_ocid", "fingerprint", "private_key_path", "private_key_password", "region"} {
+		t.Setenv("TF_VAR_"+name, "")
+		t.Setenv("OCI_"+name, "")
+	}
+	for _, name := range []string{"KEY_FILE", "TENANCY", "USER", "REGION", "FINGERPRINT", "CONFIG_FILE", "PROFILE"} {
+		t.Setenv("OCI_CLI_"+name, "")
+		t.Setenv("OCI_"+name, "")
+	}
+}
+
+func apiKeyID(user string) string {
+	return testTenancy + "/" + user + "/" + testFingerprint
+}
+
+func TestGetProviderForAPIkey(t *testing.T) {
+	key, keyPath := testKey(t, "")
+	encryptedKey, encryptedKeyPath := testKey(t, "secret")
+	otherKey, _ := testKey(t, "")
+	keyPem, _ := os.ReadFile(keyPath)
+
+	server := newSignatureServer(t, map[string]*rsa.PublicKey{
+		apiKeyID(testUser):                           &key.PublicKey,
+		apiKeyID("ocid1.user.oc1..encrypted"):        &encryptedKey.PublicKey,
+		apiKeyID("ocid1.user.oc1..profile"):          &key.PublicKey,
+		apiKeyID("ocid1.user.oc1..encryptedprofile"): &encryptedKey.PublicKey,
+		apiKeyID("ocid1.user.oc1..environment"):      &key.PublicKey,
+		apiKeyID("ocid1.user.oc1..otherkey"):         &otherKey.PublicKey,
+	})
+
+	configPath := writeTestFile(t, "config", fmt.Sprintf(`[DEFAULT]
+tenancy=%[1]s
+region=%[2]s
+
+[profile]
+tenancy=%[1]s
+user=ocid1.user.oc1..profile
+fingerprint=%[3]s
+key_file=%[4]s
+
+[encrypted]
+tenancy=%[1]s
+user=ocid1.user.oc1..encryptedprofile
+fingerprint=%[3]s
+key_file=%[5]s
+pass_phrase=secret
+
+[partial]
+key_file=%[4]s
+
+[otherkey]
+tenancy=%[1]s
+user=ocid1.user.oc1..otherkey
+fingerprint=%[3]s
+key_file=%[4]s
+`, testTenancy, testRegion, testFingerprint, keyPath, encryptedKeyPath))
+
+	tests := []struct {
+		name    string
+		config  ociConfig
+		env     map[string]string
+		keyID   string
+		wantErr string
+	}{
+		{
+			name:   "private key path",
+			config: ociConfig{TenancyOCID: types.String(testTenancy), UserOCID: types.String(testUser), Fingerprint: types.String(testFingerprint), PrivateKeyPath: types.String(keyPath)},
+			keyID:  apiKeyID(testUser),
+		},
+		{
+			name:   "private key",
+			config: ociConfig{TenancyOCID: types.String(testTenancy), UserOCID: types.String(testUser), Fingerprint: types.String(testFingerprint), PrivateKey: types.String(string(keyPem))},
+			keyID:  apiKeyID(testUser),
+		},
+		{
+			name:   "encrypted private key",
+			config: ociConfig{TenancyOCID: types.String(testTenancy), UserOCID: types.String("ocid1.user.oc1..encrypted"), Fingerprint: types.String(testFingerprint), PrivateKeyPath: types.String(encryptedKeyPath), PrivateKeyPassword: types.String("secret")},
+			keyID:  apiKeyID("ocid1.user.oc1..encrypted"),
+		},
+		{
+			name:    "encrypted private key with a wrong password",
+			config:  ociConfig{TenancyOCID: types.String(testTenancy), UserOCID: types.String("ocid1.user.oc1..encrypted"), Fingerprint: types.String(testFingerprint), PrivateKeyPath: types.String(encryptedKeyPath), PrivateKeyPassword: types.String("wrong")},
+			wantErr: "bad configuration",
+		},
+		{
+			name:    "missing private key file",
+			config:  ociConfig{TenancyOCID: types.String(testTenancy), UserOCID: types.String(testUser), Fingerprint: types.String(testFingerprint), PrivateKeyPath: types.String(filepath.Join(t.TempDir(), "missing.pem"))},
+			wantErr: "can not read private key from",
+		},
+		{
+			name:    "private key not matching the key id",
+			config:  ociConfig{TenancyOCID: types.String(testTenancy), UserOCID: types.String(testUser), Fingerprint: types.String(testFingerprint), PrivateKeyPath: types.String(encryptedKeyPath), PrivateKeyPassword: types.String("secret")},
+			wantErr: "invalid signature",
+		},
+		{
+			name:   "config file profile",
+			config: ociConfig{Profile: types.String("profile"), ConfigPath: types.String(configPath)},
+			keyID:  apiKeyID("ocid1.user.oc1..profile"),
+		},
+		{
+			name:   "config file profile with an encrypted private key",
+			config: ociConfig{Profile: types.String("encrypted"), ConfigPath: types.String(configPath)},
+			keyID:  apiKeyID("ocid1.user.oc1..encryptedprofile"),
+		},
+		{
+			name:   "config file profile completed by the environment variables",
+			config: ociConfig{Profile: types.String("partial"), ConfigPath: types.String(configPath)},
+			env:    map[string]string{"TF_VAR_tenancy_ocid": testTenancy, "TF_VAR_user_ocid": "ocid1.user.oc1..environment", "TF_VAR_fingerprint": testFingerprint},
+			keyID:  apiKeyID("ocid1.user.oc1..environment"),
+		},
+		{
+			name:    "config file profile with an unregistered key",
+			config:  ociConfig{Profile: types.String("otherkey"), ConfigPath: types.String(configPath)},
+			wantErr: "invalid signature",
+		},
+		{
+			name:    "profile missing from the config file",
+			config:  ociConfig{Profile: types.String("missing"), ConfigPath: types.String(configPath)},
+			wantErr: "bad configuration",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isolateAuthEnv(t)
+			for name, value := range test.env {
+				t.Setenv(name, value)
+			}
+
+			server.keyIDs = nil
+			provider, err := getProviderForAPIkey(testRegion, test.config)
+			if err == nil {
+				err = callSignatureServer(server, provider)
+			}
+			checkSignatureResult(t, server, err, test.keyID, test.wantErr)
+		})
+	}
+}
+
+func TestGetProviderFromCLIEnvironmentVariables(t *testing.T) {
+	key, keyPath := testKey(t, "")
+	server := newSignatureServer(t, map[string]*rsa.PublicKey{
+		apiKeyID(testUser): &key.PublicKey,
+	})
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		keyID   string
+		wantErr string
+	}{
+		{
+			name:  "OCI_CLI_ variables",
+			env:   map[string]string{"OCI_CLI_TENANCY": testTenancy, "OCI_CLI_USER": testUser, "OCI_CLI_FINGERPRINT": testFingerprint, "OCI_CLI_KEY_FILE": keyPath, "OCI_CLI_REGION": testRegion},
+			keyID: apiKeyID(testUser),
+		},
+		{
+			name:  "OCI_ variables",
+			env:   map[string]string{"OCI_TENANCY": testTenancy, "OCI_USER": testUser, "OCI_FINGERPRINT": testFingerprint, "OCI_KEY_FILE": keyPath, "OCI_REGION": testRegion},
+			keyID: apiKeyID(testUser),
+		},
+		{
+			name:  "OCI_CLI_ variables take precedence",
+			env:   map[string]string{"OCI_CLI_TENANCY": testTenancy, "OCI_CLI_USER": testUser, "OCI_USER": "ocid1.user.oc1..other", "OCI_CLI_FINGERPRINT": testFingerprint, "OCI_CLI_KEY_FILE": keyPath, "OCI_CLI_REGION": testRegion},
+			keyID: apiKeyID(testUser),
+		},
+		{
+			name:    "missing key file",
+			env:     map[string]string{"OCI_CLI_TENANCY": testTenancy, "OCI_CLI_USER": testUser, "OCI_CLI_FINGERPRINT": testFingerprint, "OCI_CLI_KEY_FILE": filepath.Join(t.TempDir(), "missing.pem"), "OCI_CLI_REGION": testRegion},
+			wantErr: "can not read private key from",
+		},
+		{
+			name:    "missing user",
+			env:     map[string]string{"OCI_CLI_TENANCY": testTenancy, "OCI_CLI_FINGERPRINT": testFingerprint, "OCI_CLI_KEY_FILE": keyPath, "OCI_CLI_REGION": testRegion},
+			wantErr: "bad configuration",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isolateAuthEnv(t)
+			for name, value := range test.env {
+				t.Setenv(name, value)
+			}
+
+			server.keyIDs = nil
+			provider, err := getProviderFromCLIEnvironmentVariables()
+			if err == nil {
+				err = callSignatureServer(server, provider)
+			}
+			checkSignatureResult(t, server, err, test.keyID, test.wantErr)
+		})
+	}
+
+	// without any connection config, the API key provider falls back to the environment variables
+	t.Run("API key provider fallback", func(t *testing.T) {
+		for _, path := range []string{filepath.Join(getHomeFolder(), ".oci", "config"), filepath.Join(getHomeFolder(), ".oraclebmc", "config")} {
+			if _, err := os.Stat(path); err == nil {
+				t.Skipf("the default config file %s takes precedence over the environment variables", path)
+			}
+		}
+		isolateAuthEnv(t)
+		for name, value := range tests[0].env {
+			t.Setenv(name, value)
+		}
+
+		server.keyIDs = nil
+		provider, err := getProviderForAPIkey(testRegion, ociConfig{})
+		if err == nil {
+			err = callSignatureServer(server, provider)
+		}
+		checkSignatureResult(t, server, err, apiKeyID(testUser), "")
+	})
+}
+
+func TestGetProviderForSecurityToken(t *testing.T) {
+	key, keyPath := testKey(t, "")
+	encryptedKey, encryptedKeyPath := testKey(t, "secret")
+	token := testSecurityToken(time.Now().Add(time.Hour))
+	tokenPath := writeTestFile(t, "token", token+"\n")
+	expiredTokenPath := writeTestFile(t, "token", testSecurityToken(time.Now().Add(-time.Hour)))
+	invalidTokenPath := writeTestFile(t, "token", "not a token")
+
+	server := newSignatureServer(t, map[string]*rsa.PublicKey{
+		"ST$" + token: &key.PublicKey,
+	})
+	encryptedServer := newSignatureServer(t, map[string]*rsa.PublicKey{
+		"ST$" + token: &encryptedKey.PublicKey,
+	})
+
+	// `oci session authenticate` writes profiles without user
+	configPath := writeTestFile(t, "config", fmt.Sprintf(`[DEFAULT]
+tenancy=%[1]s
+region=%[2]s
+fingerprint=%[3]s
+
+[session]
+key_file=%[4]s
+security_token_file=%[5]s
+
+[encrypted]
+key_file=%[6]s
+pass_phrase=secret
+security_token_file=%[5]s
+
+[expired]
+key_file=%[4]s
+security_token_file=%[7]s
+
+[invalid]
+key_file=%[4]s
+security_token_file=%[8]s
+
+[missingtoken]
+key_file=%[4]s
+security_token_file=%[9]s
+
+[notoken]
+key_file=%[4]s
+
+[nokey]
+security_token_file=%[5]s
+`, testTenancy, testRegion, testFingerprint, keyPath, tokenPath, encryptedKeyPath, expiredTokenPath, invalidTokenPath, filepath.Join(t.TempDir(), "missing")))
+
+	tests := []struct {
+		name    string
+		config  ociConfig
+		server  *signatureServer
+		keyID   string
+		wantErr string
+	}{
+		{
+			name:   "session profile",
+			config: ociConfig{Profile: types.String("session"), ConfigPath: types.String(configPath)},
+			server: server,
+			keyID:  "ST$" + token,
+		},
+		{
+			name:   "session profile with an encrypted private key",
+			config: ociConfig{Profile: types.String("encrypted"), ConfigPath: types.String(configPath)},
+			server: encryptedServer,
+			keyID:  "ST$" + token,
+		},
+		{
+			name:    "no profile",
+			config:  ociConfig{ConfigPath: types.String(configPath)},
+			wantErr: "'config_file_profile'must be set",
+		},
+		{
+			name:    "profile missing from the config file",
+			config:  ociConfig{Profile: types.String("missing"), ConfigPath: types.String(configPath)},
+			wantErr: "configuration file did not contain profile: missing",
+		},
+		{
+			name:    "missing config file",
+			config:  ociConfig{Profile: types.String("session"), ConfigPath: types.String(filepath.Join(t.TempDir(), "config"))},
+			wantErr: "no such file or directory",
+		},
+		{
+			name:    "expired token",
+			config:  ociConfig{Profile: types.String("expired"), ConfigPath: types.String(configPath)},
+			wantErr: "expired at",
+		},
+		{
+			name:    "invalid token",
+			config:  ociConfig{Profile: types.String("invalid"), ConfigPath: types.String(configPath)},
+			wantErr: "token is not a JWT",
+		},
+		{
+			name:    "missing token file",
+			config:  ociConfig{Profile: types.String("missingtoken"), ConfigPath: types.String(configPath)},
+			wantErr: "can not read security token",
+		},
+		{
+			name:    "profile without token file",
+			config:  ociConfig{Profile: types.String("notoken"), ConfigPath: types.String(configPath)},
+			wantErr: "does not contain a 'security_token_file'",
+		},
+		{
+			name:    "profile without key file",
+			config:  ociConfig{Profile: types.String("nokey"), ConfigPath: types.String(configPath)},
+			wantErr: "does not contain a 'key_file'",
+		},
+	}
+
+	ctx := context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			isolateAuthEnv(t)
+
+			provider, err := getProviderForSecurityToken(ctx, testRegion, test.config)
+			if err == nil {
+				test.server.keyIDs = nil
+				err = callSignatureServer(test.server, provider)
+			}
+			checkSignatureResult(t, test.server, err, test.keyID, test.wantErr)
+		})
+	}
+
+	// a session refreshed with `oci session refresh` is used without creating the provider again
+	t.Run("refreshed token", func(t *testing.T) {
+		isolateAuthEnv(t)
+		refreshedTokenPath := writeTestFile(t, "token", token)
+		refreshedConfigPath := writeTestFile(t, "config", fmt.Sprintf("[session]\ntenancy=%s\nfingerprint=%s\nkey_file=%s\nsecurity_token_file=%s\n", testTenancy, testFingerprint, keyPath, refreshedTokenPath))
+
+		provider, err := getProviderForSecurityToken(ctx, testRegion, ociConfig{Profile: types.String("session"), ConfigPath: types.String(refreshedConfigPath)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		refreshed := testSecurityToken(time.Now().Add(2 * time.Hour))
+		if err := os.WriteFile(refreshedTokenPath, []byte(refreshed), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(refreshedTokenPath, time.Now(), time.Now().Add(time.Minute)); err != nil {
+			t.Fatal(err)
+		}
+
+		server.keys["ST$"+refreshed] = &key.PublicKey
+		server.keyIDs = nil
+		checkSignatureResult(t, server, callSignatureServer(server, provider), "ST$"+refreshed, "")
+	})
+}
+
+func TestCheckProfile(t *testing.T) {
+	configPath := writeTestFile(t, "config", "[DEFAULT]\ntenancy=t\n\n# comment\n[dev]\nuser = u\n")
+
+	tests := []struct {
+		profile string
+		path    string
+		wantErr string
+	}{
+		{"DEFAULT", configPath, ""},
+		{"dev", configPath, ""},
+		{"prod", configPath, "configuration file did not contain profile: prod"},
+		{"dev", filepath.Join(t.TempDir(), "config"), "no such file or directory"},
+	}
+	for _, test := range tests {
+		err := checkProfile(test.profile, test.path)
+		if test.wantErr == "" && err != nil {
+			t.Errorf("checkProfile(%q, %q) returned error %v", test.profile, test.path, err)
+		}
+		if test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+			t.Errorf("checkProfile(%q, %q) returned error %v, want %q", test.profile, test.path, err, test.wantErr)
+		}
+	}
+
+	// the settings missing from a profile are taken from the DEFAULT profile
+	settings, err := readProfileSettings("dev", configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings["tenancy"] != "t" || settings["user"] != "u" {
+		t.Errorf("readProfileSettings returned %v, want the tenancy of DEFAULT and the user of dev", settings)
+	}
+}
+
+// checkSignatureResult checks that a call to the server was signed with the key id, or failed with the error
+func checkSignatureResult(t *testing.T, server *signatureServer, err error, keyID string, wantErr string) {
+	t.Helper()
+	if wantErr != "" {
+		if err == nil || !strings.Contains(err.Error(), wantErr) {
+			t.Fatalf("got error %v, want %q", err, wantErr)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	for _, got := range server.keyIDs {
+		if got != keyID {
+			t.Errorf("request was signed with key id %q, want %q", got, keyID)
+		}
+	}
+	if len(server.keyIDs) != 2 {
+		t.Errorf("server accepted %d requests, want 2", len(server.keyIDs))
+	}
+}
@@ -21,9 +21,11 @@ func tableAdmKnowledgeBase(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAdmKnowledgeBase,
+			Tags:       map[string]string{"service": "adm", "action": "GetKnowledgeBase"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAdmKnowledgeBases,
+			Tags:    map[string]string{"service": "adm", "action": "ListKnowledgeBases"},
 			// If the resource is not available in a given compartment the API throws NotAuthorizedOrNotFound error code
 			IgnoreConfig: &plugin.IgnoreConfig{
 				ShouldIgnoreErrorFunc: isNotFoundErrorCode([]string{"NotAuthorizedOrNotFound"}),
@@ -193,7 +195,7 @@ func getAdmKnowledgeBase(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		id = *h.Item.(adm.KnowledgeBaseSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
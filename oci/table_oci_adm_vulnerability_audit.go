@@ -21,9 +21,11 @@ func tableAdmVulnerabilityAudit(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAdmVulnerabilityAudit,
+			Tags:       map[string]string{"service": "adm", "action": "GetVulnerabilityAudit"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAdmVulnerabilityAudits,
+			Tags:    map[string]string{"service": "adm", "action": "ListVulnerabilityAudits"},
 			// If the resource is not available in a given compartment the API throws NotAuthorizedOrNotFound error code
 			IgnoreConfig: &plugin.IgnoreConfig{
 				ShouldIgnoreErrorFunc: isNotFoundErrorCode([]string{"NotAuthorizedOrNotFound"}),
@@ -264,7 +266,7 @@ func getAdmVulnerabilityAudit(ctx context.Context, d *plugin.QueryData, h *plugi
 		id = *h.Item.(adm.VulnerabilityAuditSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
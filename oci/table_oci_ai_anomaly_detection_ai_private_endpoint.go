@@ -22,9 +22,11 @@ func tableAiAnomalyDetectionAiPrivateEndpoint(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAiAnomalyDetectionAiPrivateEndpoint,
+			Tags:       map[string]string{"service": "aianomalydetection", "action": "GetAiPrivateEndpoint"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAiAnomalyDetectionAiPrivateEndpoints,
+			Tags:    map[string]string{"service": "aianomalydetection", "action": "ListAiPrivateEndpoints"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -200,7 +202,7 @@ func getAiAnomalyDetectionAiPrivateEndpoint(ctx context.Context, d *plugin.Query
 		id = *h.Item.(aianomalydetection.AiPrivateEndpointSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -22,9 +22,11 @@ func tableAiAnomalyDetectionDataAsset(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAiAnomalyDetectionDataAsset,
+			Tags:       map[string]string{"service": "aianomalydetection", "action": "GetDataAsset"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAiAnomalyDetectionDataAssets,
+			Tags:    map[string]string{"service": "aianomalydetection", "action": "ListDataAssets"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -198,7 +200,7 @@ func getAiAnomalyDetectionDataAsset(ctx context.Context, d *plugin.QueryData, h
 		id = *h.Item.(aianomalydetection.DataAssetSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -22,9 +22,11 @@ func tableAiAnomalyDetectionModel(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAiAnomalyDetectionModel,
+			Tags:       map[string]string{"service": "aianomalydetection", "action": "GetModel"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAiAnomalyDetectionModels,
+			Tags:    map[string]string{"service": "aianomalydetection", "action": "ListModels"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -200,7 +202,7 @@ func getAiAnomalyDetectionModel(ctx context.Context, d *plugin.QueryData, h *plu
 		id = *h.Item.(aianomalydetection.ModelSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -22,9 +22,11 @@ func tableAiAnomalyDetectionProject(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAiAnomalyDetectionProject,
+			Tags:       map[string]string{"service": "aianomalydetection", "action": "GetProject"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAiAnomalyDetectionProjects,
+			Tags:    map[string]string{"service": "aianomalydetection", "action": "ListProjects"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -184,7 +186,7 @@ func getAiAnomalyDetectionProject(ctx context.Context, d *plugin.QueryData, h *p
 		id = *h.Item.(aianomalydetection.ProjectSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
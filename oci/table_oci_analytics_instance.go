@@ -21,9 +21,11 @@ func tableAnalyticsInstance(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAnalyticsInstance,
+			Tags:       map[string]string{"service": "analytics", "action": "GetAnalyticsInstance"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAnalyticsInstances,
+			Tags:    map[string]string{"service": "analytics", "action": "ListAnalyticsInstances"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -260,7 +262,7 @@ func getAnalyticsInstance(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 		id = *h.Item.(analytics.AnalyticsInstanceSummary).Id
 	} else {
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 
@@ -21,9 +21,11 @@ func tableApiGatewayApi(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getApiGatewayApi,
+			Tags:       map[string]string{"service": "apigateway", "action": "GetApi"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listApiGatewayApis,
+			Tags:    map[string]string{"service": "apigateway", "action": "ListApis"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -221,7 +223,7 @@ func getApiGatewayApi(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	logger.Debug("getApiGatewayApi", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
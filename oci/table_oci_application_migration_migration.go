@@ -16,6 +16,7 @@ func tableApplicationMigrationMigration(_ context.Context) *plugin.Table {
 		Description: "[DEPRECATED] OCI Application Migration Migration",
 		List: &plugin.ListConfig{
 			Hydrate: listApplicationMigrationMigrations,
+			Tags:    map[string]string{"service": "applicationmigration", "action": "ListMigrations"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -15,8 +15,11 @@ func tableApplicationMigrationSource(_ context.Context) *plugin.Table {
 		Name:        "oci_application_migration_source",
 		Description: "[DEPRECATED] OCI Application Migration Source",
 		List: &plugin.ListConfig{
-			Hydrate:           listApplicationMigrationSources,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate: listApplicationMigrationSources,
+			Tags:    map[string]string{"service": "applicationmigration", "action": "ListSources"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -22,9 +22,11 @@ func tableArtifactContainerImage(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getArtifactContainerImage,
+			Tags:       map[string]string{"service": "artifacts", "action": "GetContainerImage"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listArtifactContainerImages,
+			Tags:    map[string]string{"service": "artifacts", "action": "ListContainerImages"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -239,7 +241,7 @@ func getArtifactContainerImage(ctx context.Context, d *plugin.QueryData, h *plug
 		id = *h.Item.(artifacts.ContainerImageSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -22,9 +22,11 @@ func tableArtifactContainerImageSignature(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getArtifactContainerImageSignature,
+			Tags:       map[string]string{"service": "artifacts", "action": "GetContainerImageSignature"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listArtifactContainerImageSignatures,
+			Tags:    map[string]string{"service": "artifacts", "action": "ListContainerImageSignatures"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -203,7 +205,7 @@ func getArtifactContainerImageSignature(ctx context.Context, d *plugin.QueryData
 		id = *h.Item.(artifacts.ContainerImageSignatureSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -22,9 +22,11 @@ func tableArtifactContainerRepository(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getArtifactContainerRepository,
+			Tags:       map[string]string{"service": "artifacts", "action": "GetContainerRepository"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listArtifactContainerRepositories,
+			Tags:    map[string]string{"service": "artifacts", "action": "ListContainerRepositories"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -214,7 +216,7 @@ func getArtifactContainerRepository(ctx context.Context, d *plugin.QueryData, h
 		id = *h.Item.(artifacts.ContainerRepositorySummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -22,10 +22,12 @@ func tableArtifactGenericArtifact(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getArtifactGenericArtifact,
+			Tags:       map[string]string{"service": "artifacts", "action": "GetGenericArtifact"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listArtifactRepositories,
 			Hydrate:       listArtifactGenericArtifacts,
+			Tags:          map[string]string{"service": "artifacts", "action": "ListGenericArtifacts"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -232,7 +234,7 @@ func getArtifactGenericArtifact(ctx context.Context, d *plugin.QueryData, h *plu
 	logger.Debug("oci_artifacts_generic_artifact.getArtifactGenericArtifact", "Compartment", compartment, "OCI_REGION", region)
 
 	id := d.EqualsQuals["id"].GetStringValue()
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -22,9 +22,11 @@ func tableArtifactRepository(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getArtifactRepository,
+			Tags:       map[string]string{"service": "artifacts", "action": "GetRepository"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listArtifactRepositories,
+			Tags:    map[string]string{"service": "artifacts", "action": "ListRepositories"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -189,7 +191,7 @@ func getArtifactRepository(ctx context.Context, d *plugin.QueryData, h *plugin.H
 
 	id := d.EqualsQuals["id"].GetStringValue()
 
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
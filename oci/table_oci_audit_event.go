@@ -0,0 +1,390 @@
+package oci
+
+import (
+	"context"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/audit"
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableAuditEvent(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_audit_event",
+		Description: "OCI Audit Event",
+		List: &plugin.ListConfig{
+			Hydrate: listAuditEvents,
+			Tags:    map[string]string{"service": "audit", "action": "ListEvents"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:      "event_time",
+					Operators: []string{">", ">=", "=", "<", "<="},
+					Require:   plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "event_id",
+				Description: "The GUID of the event.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_time",
+				Description: "The time the event occurred, expressed in RFC 3339 timestamp format.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("EventTime.Time"),
+			},
+			{
+				Name:        "event_type",
+				Description: "The type of event that happened, e.g. com.oraclecloud.ComputeApi.GetInstance.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_name",
+				Description: "Name of the API operation that generated the event, e.g. GetInstance.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.EventName"),
+			},
+			{
+				Name:        "source",
+				Description: "The source of the event, e.g. ComputeApi.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// identity columns
+			{
+				Name:        "principal_name",
+				Description: "The name of the user or service issuing the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.PrincipalName"),
+			},
+			{
+				Name:        "principal_id",
+				Description: "The OCID of the principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.PrincipalId"),
+			},
+			{
+				Name:        "auth_type",
+				Description: "The type of authentication used, e.g. natv for native OCI or fed for a federated user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.AuthType"),
+			},
+			{
+				Name:        "caller_name",
+				Description: "The name of the user or service issuing the request, when the request is made on behalf of a principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.CallerName"),
+			},
+			{
+				Name:        "caller_id",
+				Description: "The OCID of the caller, when the request is made on behalf of a principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.CallerId"),
+			},
+			{
+				Name:        "ip_address",
+				Description: "The IP address of the source of the request.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Data.Identity.IpAddress"),
+			},
+			{
+				Name:        "user_agent",
+				Description: "The user agent of the client that made the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.UserAgent"),
+			},
+			{
+				Name:        "console_session_id",
+				Description: "This value identifies any Console session associated with this request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.ConsoleSessionId"),
+			},
+			{
+				Name:        "credentials",
+				Description: "The credential ID of the user, e.g. the API key fingerprint.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Identity.Credentials"),
+			},
+
+			// request columns
+			{
+				Name:        "request_action",
+				Description: "The HTTP method of the request, e.g. GET.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Request.Action"),
+			},
+			{
+				Name:        "request_id",
+				Description: "The opc-request-id of the request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Request.Id"),
+			},
+			{
+				Name:        "request_path",
+				Description: "The full path of the API request.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Request.Path"),
+			},
+			{
+				Name:        "request_parameters",
+				Description: "The parameters supplied by the caller during the request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.Request.Parameters"),
+			},
+			{
+				Name:        "request_headers",
+				Description: "The HTTP header fields and values in the request.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.Request.Headers"),
+			},
+
+			// response columns
+			{
+				Name:        "response_status",
+				Description: "The status code of the response, e.g. 200.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Response.Status"),
+			},
+			{
+				Name:        "response_time",
+				Description: "The time of the response to the audited request.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Data.Response.ResponseTime.Time"),
+			},
+			{
+				Name:        "response_message",
+				Description: "A friendly description of what happened during the operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.Response.Message"),
+			},
+			{
+				Name:        "response_headers",
+				Description: "The headers of the response.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.Response.Headers"),
+			},
+			{
+				Name:        "response_payload",
+				Description: "This value is included for backward compatibility with the Audit version 1 schema, where it contained metadata of interest from the response payload.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.Response.Payload"),
+			},
+
+			// resource columns
+			{
+				Name:        "resource_id",
+				Description: "An OCID or some other ID for the resource emitting the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.ResourceId"),
+			},
+			{
+				Name:        "resource_name",
+				Description: "The name of the resource emitting the event.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.ResourceName"),
+			},
+			{
+				Name:        "availability_domain",
+				Description: "The availability domain where the resource resides.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.AvailabilityDomain"),
+			},
+			{
+				Name:        "compartment_name",
+				Description: "The name of the compartment.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.CompartmentName"),
+			},
+
+			// other columns
+			{
+				Name:        "cloud_events_version",
+				Description: "The version of the CloudEvents specification.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_type_version",
+				Description: "The version of the event type.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "content_type",
+				Description: "The content type of the data contained in data, e.g. application/json.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "event_grouping_id",
+				Description: "This value links multiple audit events that are part of the same API operation.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.EventGroupingId"),
+			},
+			{
+				Name:        "state_change",
+				Description: "The current and previous state of the resource.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.StateChange"),
+			},
+			{
+				Name:        "additional_details",
+				Description: "A container object for attributes unique to the resource emitting the event.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.AdditionalDetails"),
+			},
+			{
+				Name:        "data",
+				Description: "The payload of the event.",
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.DefinedTags"),
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Data.FreeformTags"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.EventName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.CompartmentId"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type auditEventInfo struct {
+	audit.AuditEvent
+	Region string
+}
+
+//// LIST FUNCTION
+
+func listAuditEvents(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	compartment := d.EqualsQualString(matrixKeyCompartment)
+	logger.Debug("oci.listAuditEvents", "Compartment", compartment, "OCI_REGION", region)
+
+	// Return nil, if given compartment_id doesn't match
+	if d.EqualsQuals["compartment_id"] != nil && compartment != d.EqualsQualString("compartment_id") {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := auditService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_audit_event.listAuditEvents", "connection_error", err)
+		return nil, err
+	}
+
+	startTime, endTime := getAuditEventTimeRange(d, time.Now())
+
+	request := audit.ListEventsRequest{
+		CompartmentId: types.String(compartment),
+		StartTime:     &common.SDKTime{Time: startTime},
+		EndTime:       &common.SDKTime{Time: endTime},
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.AuditClient.ListEvents(ctx, request)
+		if err != nil {
+			logger.Error("oci_audit_event.listAuditEvents", "api_error", err)
+			return nil, err
+		}
+
+		for _, event := range response.Items {
+			d.StreamListItem(ctx, auditEventInfo{event, region})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+// getAuditEventTimeRange returns the time range of the events to list from the event_time quals, defaulting
+// to the last day. The API truncates the time range to the minute, so the end time is rounded up to the next
+// minute, and the rows are filtered on the exact event_time afterwards.
+func getAuditEventTimeRange(d *plugin.QueryData, now time.Time) (time.Time, time.Time) {
+	var startTime, endTime *time.Time
+	if d.Quals["event_time"] != nil {
+		for _, q := range d.Quals["event_time"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				startTime = &timestamp
+				end := timestamp.Add(time.Minute)
+				endTime = &end
+			case ">=", ">":
+				startTime = &timestamp
+			case "<", "<=":
+				end := timestamp.Add(time.Minute)
+				endTime = &end
+			}
+		}
+	}
+	if endTime == nil {
+		endTime = &now
+	}
+	// the day before the end time, so that a range with only an upper bound stays valid
+	if startTime == nil {
+		start := endTime.Add(-24 * time.Hour)
+		startTime = &start
+	}
+
+	return *startTime, *endTime
+}
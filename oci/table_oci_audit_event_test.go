@@ -0,0 +1,78 @@
+package oci
+
+import (
+	"testing"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGetAuditEventTimeRange(t *testing.T) {
+	now := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+	newYear := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		quals     map[string]time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "no qual",
+			wantStart: now.Add(-24 * time.Hour),
+			wantEnd:   now,
+		},
+		{
+			name:      "lower bound",
+			quals:     map[string]time.Time{">=": newYear},
+			wantStart: newYear,
+			wantEnd:   now,
+		},
+		{
+			name:      "upper bound",
+			quals:     map[string]time.Time{"<": newYear},
+			wantStart: newYear.Add(time.Minute - 24*time.Hour),
+			wantEnd:   newYear.Add(time.Minute),
+		},
+		{
+			name:      "both bounds",
+			quals:     map[string]time.Time{">": newYear, "<=": newYear.Add(time.Hour)},
+			wantStart: newYear,
+			wantEnd:   newYear.Add(time.Hour + time.Minute),
+		},
+		{
+			name:      "equal",
+			quals:     map[string]time.Time{"=": newYear},
+			wantStart: newYear,
+			wantEnd:   newYear.Add(time.Minute),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &plugin.QueryData{Quals: plugin.KeyColumnQualMap{}}
+			if len(test.quals) > 0 {
+				eventTimeQuals := &plugin.KeyColumnQuals{Name: "event_time"}
+				for operator, value := range test.quals {
+					eventTimeQuals.Quals = append(eventTimeQuals.Quals, &quals.Qual{
+						Column:   "event_time",
+						Operator: operator,
+						Value:    &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(value)}},
+					})
+				}
+				d.Quals["event_time"] = eventTimeQuals
+			}
+
+			start, end := getAuditEventTimeRange(d, now)
+			if !start.Equal(test.wantStart) || !end.Equal(test.wantEnd) {
+				t.Errorf("getAuditEventTimeRange() = %s, %s, want %s, %s", start, end, test.wantStart, test.wantEnd)
+			}
+			if !start.Before(end) {
+				t.Errorf("getAuditEventTimeRange() start %s is not before end %s", start, end)
+			}
+		})
+	}
+}
@@ -21,9 +21,11 @@ func tableAutoScalingConfiguration(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAutoScalingConfiguration,
+			Tags:       map[string]string{"service": "autoscaling", "action": "GetAutoScalingConfiguration"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAutoScalingConfigurations,
+			Tags:    map[string]string{"service": "autoscaling", "action": "ListAutoScalingConfigurations"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -217,7 +219,7 @@ func getAutoScalingConfiguration(ctx context.Context, d *plugin.QueryData, h *pl
 	logger.Debug("getAutoScalingConfiguration", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -228,7 +230,7 @@ func getAutoScalingConfiguration(ctx context.Context, d *plugin.QueryData, h *pl
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
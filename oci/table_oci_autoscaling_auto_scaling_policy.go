@@ -21,10 +21,12 @@ func tableAutoScalingPolicy(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.AllColumns([]string{"id", "auto_scaling_configuration_id"}),
 			Hydrate:    getAutoscalingAutoScalingPolicy,
+			Tags:       map[string]string{"service": "autoscaling", "action": "GetAutoScalingPolicy"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listAutoScalingConfigurations,
 			Hydrate:       listAutoscalingAutoScalingPolicies,
+			Tags:          map[string]string{"service": "autoscaling", "action": "ListAutoScalingPolicies"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "auto_scaling_configuration_id",
@@ -192,7 +194,7 @@ func getAutoscalingAutoScalingPolicy(ctx context.Context, d *plugin.QueryData, h
 	logger.Debug("oci_autoscaling_auto_scaling_policy.getAutoscalingAutoScalingPolicy", "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
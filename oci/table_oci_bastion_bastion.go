@@ -22,9 +22,11 @@ func tableBastion(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getBastion,
+			Tags:       map[string]string{"service": "bastion", "action": "GetBastion"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listBastions,
+			Tags:    map[string]string{"service": "bastion", "action": "ListBastions"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -249,7 +251,7 @@ func getBastion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment and one zone/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -20,10 +20,12 @@ func tableBastionSession(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getBastionSession,
+			Tags:       map[string]string{"service": "bastion", "action": "GetSession"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listBastions,
 			Hydrate:       listBastionSessions,
+			Tags:          map[string]string{"service": "bastion", "action": "ListSessions"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "bastion_id",
@@ -21,9 +21,11 @@ func tableBigDataServiceInstance(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getBigDataServiceInstance,
+			Tags:       map[string]string{"service": "bds", "action": "GetBdsInstance"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listBigDataServiceInstances,
+			Tags:    map[string]string{"service": "bds", "action": "ListBdsInstances"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -250,7 +252,7 @@ func getBigDataServiceInstance(ctx context.Context, d *plugin.QueryData, h *plug
 	region := d.EqualsQualString(matrixKeyRegion)
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 	logger.Debug("oci_bds_bds_instance.getBigDataServiceInstance", "Compartment", compartment, "OCI_REGION", region)
-	if h.Item == nil && !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if h.Item == nil && !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
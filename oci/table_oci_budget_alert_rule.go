@@ -21,10 +21,12 @@ func tableBudgetAlertRule(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.AllColumns([]string{"id", "budget_id"}),
 			Hydrate:    getBudgetAlertRule,
+			Tags:       map[string]string{"service": "budget", "action": "GetAlertRule"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listBudgets,
 			Hydrate:       listBudgetAlertRules,
+			Tags:          map[string]string{"service": "budget", "action": "ListAlertRules"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "display_name",
@@ -287,7 +289,7 @@ func getBudgetAlertRule(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	logger.Debug("getBudgetAlertRule", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,9 +21,11 @@ func tableBudget(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getBudget,
+			Tags:       map[string]string{"service": "budget", "action": "GetBudget"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listBudgets,
+			Tags:    map[string]string{"service": "budget", "action": "ListBudgets"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -261,7 +263,7 @@ func getBudget(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	logger.Debug("getBudget", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -34,6 +34,7 @@ func tableCertificatesAuthorityBundle(_ context.Context) *plugin.Table {
 				},
 			},
 			Hydrate: getCertificateAuthorityBundle,
+			Tags:    map[string]string{"service": "certificates", "action": "GetCertificateAuthorityBundle"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: []*plugin.Column{
@@ -124,7 +125,7 @@ func getCertificateAuthorityBundle(ctx context.Context, d *plugin.QueryData, h *
 	logger.Debug("oci_certificates_authority_bundle.getCertificateAuthorityBundle", "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,12 +21,14 @@ func tableCertificatesManagementAssociation(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCertificatesManagementAssociation,
+			Tags:       map[string]string{"service": "certificatesmanagement", "action": "GetAssociation"},
 			IgnoreConfig: &plugin.IgnoreConfig{
 				ShouldIgnoreErrorFunc: isNotFoundErrorCode([]string{"InvalidParameter"}),
 			},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCertificatesManagementAssociations,
+			Tags:    map[string]string{"service": "certificatesmanagement", "action": "ListAssociations"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -182,7 +184,7 @@ func getCertificatesManagementAssociation(ctx context.Context, d *plugin.QueryDa
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 	logger.Debug("oci_certificates_management_association.getCertificatesManagementAssociation", "Compartment", compartment, "OCI_REGION", region)
 
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,12 +21,14 @@ func tableCertificatesManagementCaBundle(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCertificatesManagementCaBundle,
+			Tags:       map[string]string{"service": "certificatesmanagement", "action": "GetCaBundle"},
 			IgnoreConfig: &plugin.IgnoreConfig{
-				ShouldIgnoreError: isNotFoundError([]string{"InvalidParameter", "404"}),
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"InvalidParameter", "404"}),
 			},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCertificatesManagementCaBundles,
+			Tags:    map[string]string{"service": "certificatesmanagement", "action": "ListCaBundles"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -185,7 +187,7 @@ func getCertificatesManagementCaBundle(ctx context.Context, d *plugin.QueryData,
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 	logger.Debug("oci_certificates_management_ca_bundle.getCertificatesManagementCaBundle", "Compartment", compartment, "OCI_REGION", region)
 
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
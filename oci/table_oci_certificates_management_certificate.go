@@ -21,9 +21,11 @@ func tableCertificatesManagementCertificate(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCertificatesManagementCertificate,
+			Tags:       map[string]string{"service": "certificatesmanagement", "action": "GetCertificate"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCertificatesManagementCertificates,
+			Tags:    map[string]string{"service": "certificatesmanagement", "action": "ListCertificates"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -240,12 +242,12 @@ func getCertificatesManagementCertificate(ctx context.Context, d *plugin.QueryDa
 	region := d.EqualsQualString(matrixKeyRegion)
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 	logger.Debug("oci_certificates_management_certificate.getCertificatesManagementCertificate", "Compartment", compartment, "OCI_REGION", region)
-	if h.Item == nil && !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if h.Item == nil && !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
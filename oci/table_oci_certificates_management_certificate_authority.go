@@ -21,9 +21,11 @@ func tableCertificatesManagementCertificateAuthority(_ context.Context) *plugin.
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCertificatesManagementCertificateAuthority,
+			Tags:       map[string]string{"service": "certificatesmanagement", "action": "GetCertificateAuthority"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCertificatesManagementCertificateAuthorities,
+			Tags:    map[string]string{"service": "certificatesmanagement", "action": "ListCertificateAuthorities"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -233,7 +235,7 @@ func getCertificatesManagementCertificateAuthority(ctx context.Context, d *plugi
 	region := d.EqualsQualString(matrixKeyRegion)
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 	logger.Debug("oci_certificates_management_certificate_authority.getCertificatesManagementCertificateAuthority", "Compartment", compartment, "OCI_REGION", region)
-	if h.Item == nil && !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if h.Item == nil && !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
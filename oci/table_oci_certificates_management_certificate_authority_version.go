@@ -30,10 +30,12 @@ func tableCertificatesManagementCertificateAuthorityVersion(_ context.Context) *
 				},
 			},
 			Hydrate: getCertificatesManagementCertificateAuthorityVersion,
+			Tags:    map[string]string{"service": "certificatesmanagement", "action": "GetCertificateAuthorityVersion"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listCertificatesManagementCertificateAuthorities,
 			Hydrate:       listCertificatesManagementCertificateAuthorityVersions,
+			Tags:          map[string]string{"service": "certificatesmanagement", "action": "ListCertificateAuthorityVersions"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "certificate_authority_id",
@@ -194,7 +196,7 @@ func getCertificatesManagementCertificateAuthorityVersion(ctx context.Context, d
 	logger.Debug("oci_certificates_management_certificate_authority_version.getCertificatesManagementCertificateAuthorityVersion", "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
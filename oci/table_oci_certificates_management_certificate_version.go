@@ -30,10 +30,12 @@ func tableCertificatesManagementCertificateVersion(_ context.Context) *plugin.Ta
 				},
 			},
 			Hydrate: getCertificatesManagementCertificateVersion,
+			Tags:    map[string]string{"service": "certificatesmanagement", "action": "GetCertificateVersion"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listCertificatesManagementCertificates,
 			Hydrate:       listCertificatesManagementCertificateVersions,
+			Tags:          map[string]string{"service": "certificatesmanagement", "action": "ListCertificateVersions"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "certificate_id",
@@ -195,7 +197,7 @@ func getCertificatesManagementCertificateVersion(ctx context.Context, d *plugin.
 	logger.Debug("oci_certificates_management_certificate_version.getCertificatesManagementCertificateVersion", "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -17,6 +17,7 @@ func tableCloudGuardConfiguration(_ context.Context) *plugin.Table {
 		Description: "OCI Cloud Guard Configuration",
 		List: &plugin.ListConfig{
 			Hydrate: listCloudGuardConfigurations,
+			Tags:    map[string]string{"service": "cloudguard", "action": "GetConfiguration"},
 		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
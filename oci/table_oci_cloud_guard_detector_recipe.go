@@ -21,9 +21,11 @@ func tableCloudGuardDetectorRecipe(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudGuardDetectorRecipe,
+			Tags:       map[string]string{"service": "cloudguard", "action": "GetDetectorRecipe"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCloudGuardDetectorRecipes,
+			Tags:    map[string]string{"service": "cloudguard", "action": "ListDetectorRecipes"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -247,7 +249,7 @@ func getCloudGuardDetectorRecipe(ctx context.Context, d *plugin.QueryData, h *pl
 		id = *h.Item.(cloudguard.DetectorRecipeSummary).Id
 	} else {
 		// Restrict the api call to only root compartment
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
@@ -21,9 +21,11 @@ func tableCloudGuardManagedList(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudGuardManagedList,
+			Tags:       map[string]string{"service": "cloudguard", "action": "GetManagedList"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCloudGuardManagedLists,
+			Tags:    map[string]string{"service": "cloudguard", "action": "ListManagedLists"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -240,7 +242,7 @@ func getCloudGuardManagedList(ctx context.Context, d *plugin.QueryData, h *plugi
 	logger.Debug("oci.getCloudGuardManagedList", "Compartment", compartment)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,9 +21,11 @@ func tableCloudGuardResponderRecipe(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudGuardResponderRecipe,
+			Tags:       map[string]string{"service": "cloudguard", "action": "GetResponderRecipe"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCloudGuardResponderRecipes,
+			Tags:    map[string]string{"service": "cloudguard", "action": "ListResponderRecipes"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -247,7 +249,7 @@ func getCloudGuardResponderRecipe(ctx context.Context, d *plugin.QueryData, h *p
 		id = *h.Item.(cloudguard.ResponderRecipeSummary).Id
 	} else {
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
@@ -21,9 +21,11 @@ func tableCloudGuardTarget(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudGuardTarget,
+			Tags:       map[string]string{"service": "cloudguard", "action": "GetTarget"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCloudGuardTargets,
+			Tags:    map[string]string{"service": "cloudguard", "action": "ListTargets"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -260,7 +262,7 @@ func getCloudGuardTarget(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		id = *h.Item.(cloudguard.TargetSummary).Id
 	} else {
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
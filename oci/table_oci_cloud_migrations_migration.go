@@ -18,6 +18,7 @@ func tableCloudMigrationsMigration(_ context.Context) *plugin.Table {
 		DefaultTransform: transform.FromCamel(),
 		List: &plugin.ListConfig{
 			Hydrate: listCloudMigrationsMigrations,
+			Tags:    map[string]string{"service": "cloudmigrations", "action": "ListMigrations"},
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "compartment_id", Require: plugin.Optional},
 				{Name: "lifecycle_state", Require: plugin.Optional},
@@ -27,6 +28,7 @@ func tableCloudMigrationsMigration(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudMigrationsMigration,
+			Tags:       map[string]string{"service": "cloudmigrations", "action": "GetMigration"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
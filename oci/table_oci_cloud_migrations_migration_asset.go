@@ -18,6 +18,7 @@ func tableCloudMigrationsMigrationAsset(_ context.Context) *plugin.Table {
 		DefaultTransform: transform.FromCamel(),
 		List: &plugin.ListConfig{
 			Hydrate:       listCloudMigrationsMigrationAssets,
+			Tags:          map[string]string{"service": "cloudmigrations", "action": "ListMigrationAssets"},
 			ParentHydrate: listCloudMigrationsMigrations,
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "migration_id", Require: plugin.Optional},
@@ -28,6 +29,7 @@ func tableCloudMigrationsMigrationAsset(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudMigrationsMigrationAsset,
+			Tags:       map[string]string{"service": "cloudmigrations", "action": "GetMigrationAsset"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
@@ -18,6 +18,7 @@ func tableCloudMigrationsMigrationPlan(_ context.Context) *plugin.Table {
 		DefaultTransform: transform.FromCamel(),
 		List: &plugin.ListConfig{
 			Hydrate: listCloudMigrationsMigrationPlans,
+			Tags:    map[string]string{"service": "cloudmigrations", "action": "ListMigrationPlans"},
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "migration_id", Require: plugin.Optional},
 				{Name: "compartment_id", Require: plugin.Optional},
@@ -28,6 +29,7 @@ func tableCloudMigrationsMigrationPlan(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudMigrationsMigrationPlan,
+			Tags:       map[string]string{"service": "cloudmigrations", "action": "GetMigrationPlan"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
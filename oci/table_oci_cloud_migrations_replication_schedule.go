@@ -18,6 +18,7 @@ func tableCloudMigrationsReplicationSchedule(_ context.Context) *plugin.Table {
 		DefaultTransform: transform.FromCamel(),
 		List: &plugin.ListConfig{
 			Hydrate: listCloudMigrationsReplicationSchedules,
+			Tags:    map[string]string{"service": "cloudmigrations", "action": "ListReplicationSchedules"},
 			KeyColumns: []*plugin.KeyColumn{
 				{Name: "compartment_id", Require: plugin.Optional},
 				{Name: "lifecycle_state", Require: plugin.Optional},
@@ -27,6 +28,7 @@ func tableCloudMigrationsReplicationSchedule(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCloudMigrationsReplicationSchedule,
+			Tags:       map[string]string{"service": "cloudmigrations", "action": "GetReplicationSchedule"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
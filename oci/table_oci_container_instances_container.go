@@ -24,10 +24,14 @@ func tableContainerInstancesContainer(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getContainer,
+			Tags:       map[string]string{"service": "containerinstances", "action": "GetContainer"},
 		},
 		List: &plugin.ListConfig{
-			Hydrate:           listContainers,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate: listContainers,
+			Tags:    map[string]string{"service": "containerinstances", "action": "ListContainers"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -320,7 +324,7 @@ func getContainer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 	} else {
 		id = d.EqualsQualString("id")
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -23,10 +23,14 @@ func tableContainerInstancesContainerInstance(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getContainerInstance,
+			Tags:       map[string]string{"service": "containerinstances", "action": "GetContainerInstance"},
 		},
 		List: &plugin.ListConfig{
-			Hydrate:           listContainerInstances,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate: listContainerInstances,
+			Tags:    map[string]string{"service": "containerinstances", "action": "ListContainerInstances"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -292,7 +296,7 @@ func getContainerInstance(ctx context.Context, d *plugin.QueryData, h *plugin.Hy
 	} else {
 		id = d.EqualsQualString("id")
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
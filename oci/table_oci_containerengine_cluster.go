@@ -20,12 +20,16 @@ func tableOciContainerEngineCluster(_ context.Context) *plugin.Table {
 		Name:        "oci_containerengine_cluster",
 		Description: "OCI Container Engine Cluster",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			Hydrate:           getContainerEngineCluster,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
+			Hydrate: getContainerEngineCluster,
+			Tags:    map[string]string{"service": "containerengine", "action": "GetCluster"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listContainerEngineClusters,
+			Tags:    map[string]string{"service": "containerengine", "action": "ListClusters"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "name",
@@ -225,7 +229,7 @@ func getContainerEngineCluster(ctx context.Context, d *plugin.QueryData, h *plug
 	} else {
 
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
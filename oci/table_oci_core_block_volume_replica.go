@@ -21,9 +21,11 @@ func tableCoreBlockVolumeReplica(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreBlockVolumeReplica,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetBlockVolumeReplica"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreBlockVolumeReplicas,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListBlockVolumeReplicas"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -238,7 +240,7 @@ func getCoreBlockVolumeReplica(ctx context.Context, d *plugin.QueryData, _ *plug
 	logger.Debug("getCoreBlockVolumeReplica", "Compartment", compartment, "OCI_Zone", zone)
 
 	// Restrict the api call to only root compartment and one zone/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") || !strings.HasSuffix(zone, "AD-1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") || !strings.HasSuffix(zone, "AD-1") {
 		return nil, nil
 	}
 
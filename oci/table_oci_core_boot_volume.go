@@ -21,9 +21,11 @@ func tableCoreBootVolume(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getBootVolume,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetBootVolume"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listBootVolumes,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListBootVolumes"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -277,7 +279,7 @@ func getBootVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 	logger.Debug("oci.getBootVolume", "Compartment", compartment, "OCI_zone", zone)
 
 	// Restrict the api call to only root compartment and one zone/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") || !strings.HasSuffix(zone, "AD-1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") || !strings.HasSuffix(zone, "AD-1") {
 		return nil, nil
 	}
 
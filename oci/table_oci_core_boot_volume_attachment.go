@@ -21,9 +21,11 @@ func tableCoreBootVolumeAttachment(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreBootVolumeAttachment,
+			Tags:       map[string]string{"service": "compute", "action": "GetBootVolumeAttachment"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreBootVolumeAttachments,
+			Tags:    map[string]string{"service": "compute", "action": "ListBootVolumeAttachments"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -219,7 +221,7 @@ func getCoreBootVolumeAttachment(ctx context.Context, d *plugin.QueryData, _ *pl
 	logger.Debug("getCoreBootVolumeAttachment", "Compartment", compartment, "OCI_Zone", zone)
 
 	// Restrict the api call to only root compartment and one zone/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") || !strings.HasSuffix(zone, "AD-1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") || !strings.HasSuffix(zone, "AD-1") {
 		return nil, nil
 	}
 
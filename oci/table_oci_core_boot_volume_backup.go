@@ -21,9 +21,11 @@ func tableCoreBootVolumeBackup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getBootVolumeBackup,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetBootVolumeBackup"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listBootVolumeBackups,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListBootVolumeBackups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "boot_volume_id",
@@ -47,7 +49,7 @@ func tableCoreBootVolumeBackup(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("BootVolumeBackup"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "id",
@@ -256,7 +258,7 @@ func getBootVolumeBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	logger.Debug("oci.getBootVolumeBackup", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
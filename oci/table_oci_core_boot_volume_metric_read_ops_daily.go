@@ -18,6 +18,8 @@ func tableOciCoreBootVolumeMetricReadOpsDaily(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listBootVolumes,
 			Hydrate:       listCoreBootVolumeMetricReadOpsDaily,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementZonalList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -21,9 +21,11 @@ func tableCoreBootVolumeReplica(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreBootVolumeReplica,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetBootVolumeReplica"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreBootVolumeReplicas,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListBootVolumeReplicas"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -244,7 +246,7 @@ func getCoreBootVolumeReplica(ctx context.Context, d *plugin.QueryData, _ *plugi
 	logger.Debug("getCoreBootVolumeReplica", "Compartment", compartment, "OCI_Zone", zone)
 
 	// Restrict the api call to only root compartment and one zone/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") || !strings.HasSuffix(zone, "AD-1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") || !strings.HasSuffix(zone, "AD-1") {
 		return nil, nil
 	}
 
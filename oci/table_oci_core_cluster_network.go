@@ -19,12 +19,16 @@ func tableCoreClusterNetwork(_ context.Context) *plugin.Table {
 		Name:        "oci_core_cluster_network",
 		Description: "OCI Core Cluster Network",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getClusterNetwork,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"}),
+			},
+			Hydrate: getClusterNetwork,
+			Tags:    map[string]string{"service": "compute", "action": "GetClusterNetwork"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listClusterNetworks,
+			Tags:    map[string]string{"service": "compute", "action": "ListClusterNetworks"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -206,7 +210,7 @@ func getClusterNetwork(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
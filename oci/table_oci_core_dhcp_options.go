@@ -21,9 +21,11 @@ func tableCoreDhcpOptions(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreDhcpOption,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetDhcpOptions"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreDhcpOptions,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListDhcpOptions"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -202,7 +204,7 @@ func getCoreDhcpOption(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	logger.Debug("oci.getCoreDhcpOption", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
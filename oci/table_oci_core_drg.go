@@ -21,9 +21,11 @@ func tableCoreDrg(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreDrg,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetDrg"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreDrgs,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListDrgs"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -177,7 +179,7 @@ func getCoreDrg(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	logger.Debug("oci.getCoreDrg", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -19,12 +19,16 @@ func tableCoreImage(_ context.Context) *plugin.Table {
 		Name:        "oci_core_image",
 		Description: "OCI Core Image",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"404", "400"}),
-			Hydrate:           getCoreImage,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404", "400"}),
+			},
+			Hydrate: getCoreImage,
+			Tags:    map[string]string{"service": "compute", "action": "GetImage"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreImages,
+			Tags:    map[string]string{"service": "compute", "action": "ListImages"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -49,6 +53,12 @@ func tableCoreImage(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getCompartmentPath,
+				Depends: []plugin.HydrateFunc{getTenantId},
+			},
+		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -182,7 +192,7 @@ func listCoreImages(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	}
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -244,7 +254,7 @@ func getCoreImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDat
 	logger.Debug("oci.getImage", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	id := d.EqualsQuals["id"].GetStringValue()
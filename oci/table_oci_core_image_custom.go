@@ -19,12 +19,16 @@ func tableCoreImageCustom(_ context.Context) *plugin.Table {
 		Name:        "oci_core_image_custom",
 		Description: "OCI Core Image Custom",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"404", "400"}),
-			Hydrate:           getCoreCustomImage,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404", "400"}),
+			},
+			Hydrate: getCoreCustomImage,
+			Tags:    map[string]string{"service": "compute", "action": "GetImage"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreCustomImages,
+			Tags:    map[string]string{"service": "compute", "action": "ListImages"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -237,7 +241,7 @@ func getCoreCustomImage(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	logger.Debug("getCoreCustomImage", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,9 +21,11 @@ func tableCoreInstance(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getInstance,
+			Tags:       map[string]string{"service": "compute", "action": "GetInstance"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreInstances,
+			Tags:    map[string]string{"service": "compute", "action": "ListInstances"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -47,7 +49,7 @@ func tableCoreInstance(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("Instance"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "id",
@@ -338,7 +340,7 @@ func getInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	logger.Debug("oci.getInstance", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	id := d.EqualsQuals["id"].GetStringValue()
@@ -20,12 +20,16 @@ func tableCoreInstanceConfiguration(_ context.Context) *plugin.Table {
 		Name:        "oci_core_instance_configuration",
 		Description: "OCI Core Instance Configuration",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getInstanceConfiguration,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"}),
+			},
+			Hydrate: getInstanceConfiguration,
+			Tags:    map[string]string{"service": "compute", "action": "GetInstanceConfiguration"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listInstanceConfigurations,
+			Tags:    map[string]string{"service": "compute", "action": "ListInstanceConfigurations"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
@@ -183,7 +187,7 @@ func getInstanceConfiguration(ctx context.Context, d *plugin.QueryData, h *plugi
 		id = *h.Item.(core.InstanceConfigurationSummary).Id
 	} else {
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 
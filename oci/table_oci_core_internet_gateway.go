@@ -21,9 +21,11 @@ func tableCoreInternetGateway(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreInternetGateway,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetInternetGateway"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreInternetGateways,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListInternetGateways"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -205,7 +207,7 @@ func getCoreInternetGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.
 	logger.Debug("getCoreInternetGateway", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
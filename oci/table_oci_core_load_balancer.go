@@ -21,9 +21,11 @@ func tableCoreLoadBalancer(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreLoadBalancer,
+			Tags:       map[string]string{"service": "loadbalancer", "action": "GetLoadBalancer"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreLoadBalancers,
+			Tags:    map[string]string{"service": "loadbalancer", "action": "ListLoadBalancers"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -39,7 +41,7 @@ func tableCoreLoadBalancer(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("LoadBalancer"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "id",
@@ -274,7 +276,7 @@ func getCoreLoadBalancer(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	logger.Debug("oci.getCoreLoadBalancer", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -20,6 +20,7 @@ func tableCoreLocalPeeringGateway(_ context.Context) *plugin.Table {
 		Description: "OCI Core Local Peering Gateway",
 		List: &plugin.ListConfig{
 			Hydrate: listCoreLocalPeeringGateways,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListLocalPeeringGateways"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -34,6 +35,7 @@ func tableCoreLocalPeeringGateway(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreLocalPeeringGateway,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetLocalPeeringGateway"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
@@ -228,7 +230,7 @@ func getCoreLocalPeeringGateway(ctx context.Context, d *plugin.QueryData, _ *plu
 	logger.Debug("oci.getCoreLocalPeeringGateway", "Compartment", compartment, "OCI_REGION", region)
 
 	// Rstrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
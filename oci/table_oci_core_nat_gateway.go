@@ -19,12 +19,16 @@ func tableCoreNatGateway(_ context.Context) *plugin.Table {
 		Name:        "oci_core_nat_gateway",
 		Description: "OCI Core Nat Gateway",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
-			Hydrate:           getCoreNatGateway,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"}),
+			},
+			Hydrate: getCoreNatGateway,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "GetNatGateway"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreNatGateways,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListNatGateways"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -213,7 +217,7 @@ func getCoreNatGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	logger.Debug("oci.getCoreNatGateway", "Compartment", compartment, "OCI_REGION", region)
 
 	// Rstrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,9 +21,11 @@ func tableCoreNetworkLoadBalancer(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreNetworkLoadBalancer,
+			Tags:       map[string]string{"service": "networkloadbalancer", "action": "GetNetworkLoadBalancer"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreNetworkLoadBalancers,
+			Tags:    map[string]string{"service": "networkloadbalancer", "action": "ListNetworkLoadBalancers"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -39,7 +41,7 @@ func tableCoreNetworkLoadBalancer(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("NetworkLoadBalancer"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -245,7 +247,7 @@ func getCoreNetworkLoadBalancer(ctx context.Context, d *plugin.QueryData, _ *plu
 	logger.Debug("getCoreNetworkLoadBalancer", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
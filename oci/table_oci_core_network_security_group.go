@@ -21,9 +21,11 @@ func tableCoreNetworkSecurityGroup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreNetworkSecurityGroup,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetNetworkSecurityGroup"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreNetworkSecurityGroups,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListNetworkSecurityGroups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -43,7 +45,7 @@ func tableCoreNetworkSecurityGroup(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("NetworkSecurityGroup"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -202,7 +204,7 @@ func getCoreNetworkSecurityGroup(ctx context.Context, d *plugin.QueryData, _ *pl
 	logger.Debug("getCoreNetworkSecurityGroup", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
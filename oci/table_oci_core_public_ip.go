@@ -21,9 +21,11 @@ func tableCorePublicIP(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCorePublicIP,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetPublicIp"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCorePublicIPs,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListPublicIps"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -219,7 +221,7 @@ func getCorePublicIP(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 	plugin.Logger(ctx).Error("getCorePublicIP", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	id := d.EqualsQuals["id"].GetStringValue()
@@ -21,9 +21,11 @@ func tableCorePublicIPPool(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCorePublicIPPool,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetPublicIpPool"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCorePublicIPPools,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListPublicIpPools"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -194,7 +196,7 @@ func getCorePublicIPPool(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 		id = *h.Item.(core.PublicIpPoolSummary).Id
 	} else {
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
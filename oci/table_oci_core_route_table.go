@@ -21,9 +21,11 @@ func tableCoreRouteTable(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreRouteTable,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetRouteTable"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreRouteTables,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListRouteTables"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -206,7 +208,7 @@ func getCoreRouteTable(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	logger.Debug("oci.getCoreRouteTable", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,9 +21,11 @@ func tableCoreSecurityList(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreSecurityList,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetSecurityList"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreSecurityLists,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListSecurityLists"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -205,7 +207,7 @@ func getCoreSecurityList(ctx context.Context, d *plugin.QueryData, _ *plugin.Hyd
 	logger.Debug("oci.getCoreSecurityList", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
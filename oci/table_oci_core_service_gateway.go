@@ -21,9 +21,11 @@ func tableCoreServiceGateway(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreServiceGateway,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetServiceGateway"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreServiceGateways,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListServiceGateways"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -220,7 +222,7 @@ func getCoreServiceGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	logger.Debug("oci.getCoreServiceGateway", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
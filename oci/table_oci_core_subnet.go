@@ -21,9 +21,11 @@ func tableCoreSubnet(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreSubnet,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetSubnet"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreSubnets,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListSubnets"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -43,7 +45,7 @@ func tableCoreSubnet(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("Subnet"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -268,7 +270,7 @@ func getCoreSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 	logger.Debug("oci.getCoreSubnet", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
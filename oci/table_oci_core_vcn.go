@@ -21,9 +21,11 @@ func tableCoreVcn(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getVcn,
+			Tags:       map[string]string{"service": "virtualnetwork", "action": "GetVcn"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreVcns,
+			Tags:    map[string]string{"service": "virtualnetwork", "action": "ListVcns"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -39,7 +41,7 @@ func tableCoreVcn(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("Vcn"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -259,7 +261,7 @@ func getVcn(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (in
 	logger.Debug("oci.getVcn", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -23,10 +23,14 @@ func tableCoreVnicAttachment(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getVnicAttachment,
+			Tags:       map[string]string{"service": "compute", "action": "GetVnicAttachment"},
 		},
 		List: &plugin.ListConfig{
-			Hydrate:           listVnicAttachments,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate: listVnicAttachments,
+			Tags:    map[string]string{"service": "compute", "action": "ListVnicAttachments"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -302,7 +306,7 @@ func getVnicAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 	logger.Trace("getVnicAttachment", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	id := d.EqualsQuals["id"].GetStringValue()
@@ -346,7 +350,7 @@ func getVnic(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (i
 		vnicId = *h.Item.(core.VnicAttachment).VnicId
 	} else {
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 
@@ -21,9 +21,11 @@ func tableCoreVolume(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreVolume,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetVolume"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreVolumes,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListVolumes"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -47,7 +49,7 @@ func tableCoreVolume(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("Volume"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "id",
@@ -276,7 +278,7 @@ func getCoreVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 	logger.Debug("getCoreVolume", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	id := d.EqualsQuals["id"].GetStringValue()
@@ -21,9 +21,11 @@ func tableCoreVolumeAttachment(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreVolumeAttachment,
+			Tags:       map[string]string{"service": "compute", "action": "GetVolumeAttachment"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreVolumeAttachments,
+			Tags:    map[string]string{"service": "compute", "action": "ListVolumeAttachments"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -44,6 +46,12 @@ func tableCoreVolumeAttachment(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getCompartmentPath,
+				Depends: []plugin.HydrateFunc{getCoreVolumeAttachmentFields},
+			},
+		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "id",
@@ -290,7 +298,7 @@ func getCoreVolumeAttachment(ctx context.Context, d *plugin.QueryData, _ *plugin
 	logger.Debug("getCoreVolumeAttachment", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
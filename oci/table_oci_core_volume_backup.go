@@ -21,9 +21,11 @@ func tableCoreVolumeBackup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getVolumeBackup,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetVolumeBackup"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreVolumeBackups,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListVolumeBackups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -47,7 +49,7 @@ func tableCoreVolumeBackup(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("VolumeBackup"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "id",
@@ -262,7 +264,7 @@ func getVolumeBackup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrate
 	logger.Debug("getVolumeBackup", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
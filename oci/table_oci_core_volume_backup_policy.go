@@ -21,9 +21,11 @@ func tableCoreVolumeBackupPolicy(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreVolumeBackupPolicy,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetVolumeBackupPolicy"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreVolumeBackupPolicies,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListVolumeBackupPolicies"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -188,7 +190,7 @@ func getCoreVolumeBackupPolicy(ctx context.Context, d *plugin.QueryData, _ *plug
 	logger.Debug("core.getCoreVolumeBackupPolicy", "Compartment", compartment, "OCI_REGION", matrixRegion)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -20,9 +20,11 @@ func tableCoreVolumeDefaultBackupPolicy(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreVolumeDefaultBackupPolicy,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetVolumeBackupPolicy"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreVolumeDefaultBackupPolicies,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListVolumeBackupPolicies"},
 		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
@@ -21,9 +21,11 @@ func tableCoreVolumeGroup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCoreVolumeGroup,
+			Tags:       map[string]string{"service": "blockstorage", "action": "GetVolumeGroup"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCoreVolumeGroups,
+			Tags:    map[string]string{"service": "blockstorage", "action": "ListVolumeGroups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -227,7 +229,7 @@ func getCoreVolumeGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	id := d.EqualsQuals["id"].GetStringValue()
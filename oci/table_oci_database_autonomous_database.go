@@ -21,9 +21,11 @@ func tableOciDatabaseAutonomousDatabase(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getAutonomousDatabase,
+			Tags:       map[string]string{"service": "database", "action": "GetAutonomousDatabase"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listAutonomousDatabases,
+			Tags:    map[string]string{"service": "database", "action": "ListAutonomousDatabases"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "autonomous_container_database_id",
@@ -65,7 +67,7 @@ func tableOciDatabaseAutonomousDatabase(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("AutonomousDatabase"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -516,7 +518,7 @@ func getAutonomousDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 	logger.Debug("getAutonomousDatabase", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -19,6 +19,8 @@ func tableOciDatabaseAutonomousDatabaseMetricCpuUtilization(_ context.Context) *
 		List: &plugin.ListConfig{
 			ParentHydrate: listAutonomousDatabases,
 			Hydrate:       listAutonomousDatabaseMetricCpuUtilization,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
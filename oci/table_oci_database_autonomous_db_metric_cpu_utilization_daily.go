@@ -19,6 +19,8 @@ func tableOciDatabaseAutonomousDatabaseMetricCpuUtilizationDaily(_ context.Conte
 		List: &plugin.ListConfig{
 			ParentHydrate: listAutonomousDatabases,
 			Hydrate:       listAutonomousDatabaseMetricCpuUtilizationDaily,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -19,6 +19,8 @@ func tableOciDatabaseAutonomousDatabaseMetricCpuUtilizationHourly(_ context.Cont
 		List: &plugin.ListConfig{
 			ParentHydrate: listAutonomousDatabases,
 			Hydrate:       listAutonomousDatabaseMetricCpuUtilizationHourly,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -19,6 +19,8 @@ func tableOciDatabaseAutonomousDatabaseMetricStorageUtilization(_ context.Contex
 		List: &plugin.ListConfig{
 			ParentHydrate: listAutonomousDatabases,
 			Hydrate:       listAutonomousDatabaseMetricStorageUtilization,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -19,6 +19,8 @@ func tableOciDatabaseAutonomousDatabaseMetricStorageUtilizationDaily(_ context.C
 		List: &plugin.ListConfig{
 			ParentHydrate: listAutonomousDatabases,
 			Hydrate:       listAutonomousDatabaseMetricStorageUtilizationDaily,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -19,10 +19,14 @@ func tableOciDatabaseCloudVMCluster(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDatabaseCloudVmCluster,
+			Tags:       map[string]string{"service": "database", "action": "GetCloudVmCluster"},
 		},
 		List: &plugin.ListConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			Hydrate:           listDatabaseCloudVMClusters,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
+			Hydrate: listDatabaseCloudVMClusters,
+			Tags:    map[string]string{"service": "database", "action": "ListCloudVmClusters"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -444,7 +448,7 @@ func getDatabaseCloudVmCluster(ctx context.Context, d *plugin.QueryData, _ *plug
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
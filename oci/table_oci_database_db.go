@@ -21,11 +21,15 @@ func tableOciDatabase(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDatabase,
+			Tags:       map[string]string{"service": "database", "action": "GetDatabase"},
 		},
 		List: &plugin.ListConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			ParentHydrate:     listDatabaseDBHomes,
-			Hydrate:           listDatabases,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
+			ParentHydrate: listDatabaseDBHomes,
+			Hydrate:       listDatabases,
+			Tags:          map[string]string{"service": "database", "action": "ListDatabases"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -307,7 +311,7 @@ func getDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	logger.Debug("getDatabase", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
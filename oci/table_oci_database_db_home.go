@@ -20,6 +20,7 @@ func tableOciDatabaseDBHome(_ context.Context) *plugin.Table {
 		Description: "OCI Database DB Home",
 		List: &plugin.ListConfig{
 			Hydrate: listDatabaseDBHomes,
+			Tags:    map[string]string{"service": "database", "action": "ListDbHomes"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -50,6 +51,7 @@ func tableOciDatabaseDBHome(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDatabaseDBHome,
+			Tags:       map[string]string{"service": "database", "action": "GetDbHome"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
@@ -250,7 +252,7 @@ func getDatabaseDBHome(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	logger.Debug("getDatabaseDBHome", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
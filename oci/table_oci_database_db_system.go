@@ -21,9 +21,11 @@ func tableOciDatabaseDBSystem(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDatabaseDBSystem,
+			Tags:       map[string]string{"service": "database", "action": "GetDbSystem"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listDatabaseDBSystems,
+			Tags:    map[string]string{"service": "database", "action": "ListDbSystems"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -43,7 +45,7 @@ func tableOciDatabaseDBSystem(_ context.Context) *plugin.Table {
 				},
 			},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("DbSystem"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -393,7 +395,7 @@ func getDatabaseDBSystem(ctx context.Context, d *plugin.QueryData, h *plugin.Hyd
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
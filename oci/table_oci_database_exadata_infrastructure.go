@@ -19,10 +19,14 @@ func tableOciDatabaseExadataInfrastructure(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDatabaseExadataInfrastructure,
+			Tags:       map[string]string{"service": "database", "action": "GetExadataInfrastructure"},
 		},
 		List: &plugin.ListConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			Hydrate:           listDatabaseExadataInfrastructures,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
+			Hydrate: listDatabaseExadataInfrastructures,
+			Tags:    map[string]string{"service": "database", "action": "ListExadataInfrastructures"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -386,7 +390,7 @@ func getDatabaseExadataInfrastructure(ctx context.Context, d *plugin.QueryData,
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
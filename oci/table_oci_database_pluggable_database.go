@@ -19,10 +19,14 @@ func tableOciPluggableDatabase(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getPluggableDatabase,
+			Tags:       map[string]string{"service": "database", "action": "GetPluggableDatabase"},
 		},
 		List: &plugin.ListConfig{
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			Hydrate:           listDatabasePluggableDatabases,
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
+			Hydrate: listDatabasePluggableDatabases,
+			Tags:    map[string]string{"service": "database", "action": "ListPluggableDatabases"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -223,7 +227,7 @@ func getPluggableDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.Hy
 	logger.Debug("getPluggableDatabase", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
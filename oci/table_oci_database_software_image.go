@@ -21,9 +21,11 @@ func tableOciDatabaseSoftwareImage(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getSoftwareImage,
+			Tags:       map[string]string{"service": "database", "action": "GetDatabaseSoftwareImage"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listSoftwareImages,
+			Tags:    map[string]string{"service": "database", "action": "ListDatabaseSoftwareImages"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -247,7 +249,7 @@ func getSoftwareImage(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	logger.Debug("getSoftwareImage", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -25,10 +25,14 @@ func tableDevopsProject(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDevopsProject,
+			Tags:       map[string]string{"service": "devops", "action": "GetProject"},
 		},
 		List: &plugin.ListConfig{
-			Hydrate:           listDevopsProjects,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate: listDevopsProjects,
+			Tags:    map[string]string{"service": "devops", "action": "ListProjects"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -220,7 +224,7 @@ func getDevopsProject(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	id := d.EqualsQualString("id")
 
 	// Restrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
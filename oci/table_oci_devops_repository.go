@@ -24,10 +24,14 @@ func tableDevopsRepository(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getRepository,
+			Tags:       map[string]string{"service": "devops", "action": "GetRepository"},
 		},
 		List: &plugin.ListConfig{
-			Hydrate:           listRepositories,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
+			Hydrate: listRepositories,
+			Tags:    map[string]string{"service": "devops", "action": "ListRepositories"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
 			// Since we were encountering errors when retrieving results by passing the project_id as an input parameter, we have decided to remove it from the optional key qualifiers.
 			KeyColumns: []*plugin.KeyColumn{
 				{
@@ -281,7 +285,7 @@ func getRepository(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	} else {
 		id = d.EqualsQualString("id")
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
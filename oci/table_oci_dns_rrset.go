@@ -20,6 +20,7 @@ func tableDnsRecordSet(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listDnsZones,
 			Hydrate:       listDnsRecordSets,
+			Tags:          map[string]string{"service": "dns", "action": "GetZoneRecords"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -20,6 +20,7 @@ func tableDnsTsigKey(_ context.Context) *plugin.Table {
 		Description: "OCI DNS TSIG Key",
 		List: &plugin.ListConfig{
 			Hydrate: listDnsTsigKeys,
+			Tags:    map[string]string{"service": "dns", "action": "ListTsigKeys"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -42,6 +43,7 @@ func tableDnsTsigKey(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDnsTsigKey,
+			Tags:       map[string]string{"service": "dns", "action": "GetTsigKey"},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
@@ -205,7 +207,7 @@ func getDnsTsigKey(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	logger.Debug("oci.getDnsTsigKey", "Compartment", compartment)
 
 	// Rstrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	var id string
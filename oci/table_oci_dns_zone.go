@@ -20,6 +20,7 @@ func tableDnsZone(_ context.Context) *plugin.Table {
 		Description: "OCI DNS Zone",
 		List: &plugin.ListConfig{
 			Hydrate: listDnsZones,
+			Tags:    map[string]string{"service": "dns", "action": "ListZones"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -50,6 +51,7 @@ func tableDnsZone(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDnsZone,
+			Tags:       map[string]string{"service": "dns", "action": "GetZone"},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
@@ -240,7 +242,7 @@ func getDnsZone(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	logger.Debug("oci.getDnsZone", "Compartment", compartment)
 
 	// Rstrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	var id string
@@ -21,9 +21,11 @@ func tableEventsRule(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getEventsRule,
+			Tags:       map[string]string{"service": "events", "action": "GetRule"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listEventsRules,
+			Tags:    map[string]string{"service": "events", "action": "ListRules"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -234,7 +236,7 @@ func getEventsRule(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
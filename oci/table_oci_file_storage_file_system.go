@@ -19,12 +19,16 @@ func tableFileStorageFileSystem(_ context.Context) *plugin.Table {
 		Name:        "oci_file_storage_file_system",
 		Description: "OCI File Storage File System",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getFileStorageFileSystem,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"}),
+			},
+			Hydrate: getFileStorageFileSystem,
+			Tags:    map[string]string{"service": "filestorage", "action": "GetFileSystem"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listFileStorageFileSystems,
+			Tags:    map[string]string{"service": "filestorage", "action": "ListFileSystems"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -252,7 +256,7 @@ func getFileStorageFileSystem(ctx context.Context, d *plugin.QueryData, h *plugi
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment and one zone/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") || !strings.HasSuffix(zone, "AD-1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") || !strings.HasSuffix(zone, "AD-1") {
 			return nil, nil
 		}
 	}
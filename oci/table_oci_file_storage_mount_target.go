@@ -19,12 +19,16 @@ func tableFileStorageMountTarget(_ context.Context) *plugin.Table {
 		Name:        "oci_file_storage_mount_target",
 		Description: "OCI File Storage Mount Target",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getFileStorageMountTarget,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"}),
+			},
+			Hydrate: getFileStorageMountTarget,
+			Tags:    map[string]string{"service": "filestorage", "action": "GetMountTarget"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listFileStorageMountTargets,
+			Tags:    map[string]string{"service": "filestorage", "action": "ListMountTargets"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "availability_domain",
@@ -247,7 +251,7 @@ func getFileStorageMountTarget(ctx context.Context, d *plugin.QueryData, h *plug
 		id = *h.Item.(filestorage.MountTargetSummary).Id
 	} else {
 		// Restrict the api call to only root compartment and one zone/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") || !strings.HasSuffix(zone, "AD-1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") || !strings.HasSuffix(zone, "AD-1") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
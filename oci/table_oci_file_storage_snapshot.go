@@ -19,12 +19,16 @@ func tableFileStorageSnapshot(_ context.Context) *plugin.Table {
 		Name:        "oci_file_storage_snapshot",
 		Description: "OCI File Storage Snapshot",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400"}),
-			Hydrate:           getFileStorageSnapshot,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400"}),
+			},
+			Hydrate: getFileStorageSnapshot,
+			Tags:    map[string]string{"service": "filestorage", "action": "GetSnapshot"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate:       listFileStorageSnapshots,
+			Tags:          map[string]string{"service": "filestorage", "action": "ListSnapshots"},
 			ParentHydrate: listFileStorageFileSystems,
 			KeyColumns: []*plugin.KeyColumn{
 				{
@@ -241,7 +245,7 @@ func getFileStorageSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.
 	logger.Debug("getFileStorageSnapshot", "Compartment", compartment, "OCI_ZONE", zone)
 
 	// Restrict the api call to only root compartment and one zone/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") || !strings.HasSuffix(zone, "AD-1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") || !strings.HasSuffix(zone, "AD-1") {
 		return nil, nil
 	}
 
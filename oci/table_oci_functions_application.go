@@ -21,9 +21,11 @@ func tableFunctionsApplication(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getFunctionsApplication,
+			Tags:       map[string]string{"service": "functions", "action": "GetApplication"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listFunctionsApplications,
+			Tags:    map[string]string{"service": "functions", "action": "ListApplications"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -219,7 +221,7 @@ func getFunctionsApplication(ctx context.Context, d *plugin.QueryData, h *plugin
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
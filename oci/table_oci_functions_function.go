@@ -21,10 +21,12 @@ func tableFunctionsFunction(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getFunction,
+			Tags:       map[string]string{"service": "functions", "action": "GetFunction"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listFunctionsApplications,
 			Hydrate:       listFunctions,
+			Tags:          map[string]string{"service": "functions", "action": "ListFunctions"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "id",
@@ -250,7 +252,7 @@ func getFunction(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	} else {
 		functionId = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -21,6 +21,7 @@ func tableIdentityApiKey(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listUsers,
 			Hydrate:       listIdentityApiKeys,
+			Tags:          map[string]string{"service": "identity", "action": "ListApiKeys"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "user_id",
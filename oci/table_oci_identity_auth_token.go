@@ -19,6 +19,7 @@ func tableIdentityAuthToken(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listUsers,
 			Hydrate:       listIdentityAuthTokens,
+			Tags:          map[string]string{"service": "identity", "action": "ListAuthTokens"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "user_id",
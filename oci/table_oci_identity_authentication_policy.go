@@ -18,6 +18,7 @@ func tableIdentityAuthenticationPolicy(_ context.Context) *plugin.Table {
 		Description: "OCI Identity Authentication Policy",
 		List: &plugin.ListConfig{
 			Hydrate: listAuthenticationPolicy,
+			Tags:    map[string]string{"service": "identity", "action": "GetAuthenticationPolicy"},
 		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			// Password Policy
@@ -20,6 +20,7 @@ func tableIdentityAvailabilityDomain(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listRegions,
 			Hydrate:       lisAvailabilityDomains,
+			Tags:          map[string]string{"service": "identity", "action": "ListAvailabilityDomains"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
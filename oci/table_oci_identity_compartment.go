@@ -20,9 +20,11 @@ func tableIdentityCompartment(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCompartment,
+			Tags:       map[string]string{"service": "identity", "action": "GetCompartment"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listCompartments,
+			Tags:    map[string]string{"service": "identity", "action": "GetCompartment"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "lifecycle_state",
@@ -75,6 +77,28 @@ func tableIdentityCompartment(_ context.Context) *plugin.Table {
 				Name:        "is_accessible",
 				Description: "Indicates whether or not the compartment is accessible for the user making the request.",
 				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsAccessible"),
+			},
+			{
+				Name:        "path",
+				Description: "The names of the compartment and its ancestors joined by \"/\", starting with root, e.g. root/prod/network. Null if an ancestor of the compartment is not accessible.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getCompartmentHierarchy,
+				Transform:   transform.FromField("Path").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "depth",
+				Description: "The number of ancestors of the compartment below the root compartment, i.e. 1 for the compartments directly under the root compartment.",
+				Type:        proto.ColumnType_INT,
+				Hydrate:     getCompartmentHierarchy,
+				Transform:   transform.FromField("Depth"),
+			},
+			{
+				Name:        "ancestor_ids",
+				Description: "The OCIDs of the ancestors of the compartment, starting with the root compartment.",
+				Type:        proto.ColumnType_JSON,
+				Hydrate:     getCompartmentHierarchy,
+				Transform:   transform.FromField("AncestorIds"),
 			},
 			{
 				Name:        "defined_tags",
@@ -227,6 +251,36 @@ func getCompartment(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	return response.Compartment, nil
 }
 
+// compartmentHierarchy is the position of a compartment in the compartment tree of the tenancy
+type compartmentHierarchy struct {
+	Path        string
+	Depth       int
+	AncestorIds []string
+}
+
+func getCompartmentHierarchy(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	compartment := h.Item.(identity.Compartment)
+
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("oci_identity_compartment.getCompartmentHierarchy", "api_error", err)
+		return nil, err
+	}
+
+	// ancestors returns the compartment itself first and the root compartment last
+	ancestors := tree.ancestors(*compartment.Id)
+	ancestorIds := []string{}
+	for i := len(ancestors) - 1; i > 0; i-- {
+		ancestorIds = append(ancestorIds, ancestors[i])
+	}
+
+	return compartmentHierarchy{
+		Path:        tree.path(*compartment.Id),
+		Depth:       len(ancestorIds),
+		AncestorIds: ancestorIds,
+	}, nil
+}
+
 //// TRANSFORM FUNCTION
 
 func compartmentTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
@@ -19,6 +19,7 @@ func tableIdentityCustomerSecretKey(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listUsers,
 			Hydrate:       listIdentityCustomerSecretKeys,
+			Tags:          map[string]string{"service": "identity", "action": "ListCustomerSecretKeys"},
 		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
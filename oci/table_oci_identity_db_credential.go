@@ -20,10 +20,12 @@ func tableIdentityDBCredential(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDomain,
+			Tags:       map[string]string{"service": "identity", "action": "GetDomain"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listUsers,
 			Hydrate:       listIdentityDBCredentials,
+			Tags:          map[string]string{"service": "identity", "action": "ListDbCredentials"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "lifecycle_state",
@@ -20,9 +20,11 @@ func tableIdentityDomain(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getDomain,
+			Tags:       map[string]string{"service": "identity", "action": "GetDomain"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listDomains,
+			Tags:    map[string]string{"service": "identity", "action": "ListDomains"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "lifecycle_state",
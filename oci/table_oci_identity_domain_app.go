@@ -0,0 +1,287 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// the columns of oci_identity_domain_app filtered by SCIM attributes
+var identityDomainAppScimAttributes = []identityDomainScimAttribute{
+	{Column: "id", Attribute: "id"},
+	{Column: "display_name", Attribute: "displayName"},
+	{Column: "active", Attribute: "active"},
+	{Column: "is_oauth_client", Attribute: "isOAuthClient"},
+}
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainApp(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_domain_app",
+		Description: "OCI Identity Domain App",
+		List: &plugin.ListConfig{
+			Hydrate:    listIdentityDomainApps,
+			Tags:       map[string]string{"service": "identitydomains", "action": "ListApps"},
+			KeyColumns: identityDomainScimKeyColumns(identityDomainAppScimAttributes),
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The display name of the application.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the application in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "ocid",
+				Description: "The OCID of the application.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the application, which is also the client ID of OAuth clients.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the application.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Whether the application is active.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the application was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.Created"),
+			},
+			{
+				Name:        "time_last_modified",
+				Description: "The date and time the application was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.LastModified"),
+			},
+			{
+				Name:        "based_on_template",
+				Description: "The identifier of the template the application is based on.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("BasedOnTemplate.Value"),
+			},
+			{
+				Name:        "is_oauth_client",
+				Description: "Whether the application is an OAuth client.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsOAuthClient"),
+			},
+			{
+				Name:        "is_oauth_resource",
+				Description: "Whether the application is an OAuth resource server.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsOAuthResource"),
+			},
+			{
+				Name:        "is_saml_service_provider",
+				Description: "Whether the application is a SAML service provider.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsSamlServiceProvider"),
+			},
+			{
+				Name:        "is_opc_service",
+				Description: "Whether the application is an Oracle Cloud service.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsOPCService"),
+			},
+			{
+				Name:        "is_login_target",
+				Description: "Whether users can sign in to the application.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "show_in_my_apps",
+				Description: "Whether the application is shown in the My Apps page of users.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "client_type",
+				Description: "The type of the OAuth client, i.e. confidential, public or trusted.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ClientType").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "login_mechanism",
+				Description: "The protocol used to sign in to the application, e.g. OIDC or SAML.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoginMechanism").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "access_token_expiry",
+				Description: "The lifetime of the access tokens of the application, in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "refresh_token_expiry",
+				Description: "The lifetime of the refresh tokens of the application, in seconds.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "allowed_grants",
+				Description: "The OAuth grant types the application is allowed to use.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "redirect_uris",
+				Description: "The OAuth redirect URIs of the application.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "allowed_scopes",
+				Description: "The OAuth scopes the application is allowed to request.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "granted_app_roles",
+				Description: "The application roles granted to the application.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "sign_on_policy",
+				Description: "The sign-on policy of the application.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SignonPolicy"),
+			},
+			{
+				Name:        "domain_id",
+				Description: "The OCID of the identity domain of the application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainOcid"),
+			},
+			{
+				Name:        "domain_name",
+				Description: "The display name of the identity domain of the application.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain of the application.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainDefinedTags),
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainFreeformTags),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenancyOcid"),
+			},
+		}),
+	}
+}
+
+type identityDomainAppInfo struct {
+	identitydomains.App
+	DomainName *string
+	DomainUrl  *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainApps(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	domains, err := listIdentityDomainsForQuery(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain_app.listIdentityDomainApps", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		// Create Session
+		session, err := identityDomainsService(ctx, d, *domain.Url)
+		if err != nil {
+			logger.Error("oci_identity_domain_app.listIdentityDomainApps", "connection_error", err)
+			return nil, err
+		}
+
+		request := identitydomains.ListAppsRequest{
+			Filter:        buildIdentityDomainScimFilter(d, identityDomainAppScimAttributes),
+			AttributeSets: []identitydomains.AttributeSetsEnum{identitydomains.AttributeSetsAll},
+			StartIndex:    types.Int(1),
+			Count:         types.Int(identityDomainScimCount(d)),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.IdentityDomainsClient.ListApps(ctx, request)
+			if err != nil {
+				logger.Error("oci_identity_domain_app.listIdentityDomainApps", "api_error", err)
+				return nil, err
+			}
+
+			for _, app := range response.Resources {
+				d.StreamListItem(ctx, identityDomainAppInfo{app, domain.DisplayName, domain.Url})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// SCIM pages are addressed by the 1-based index of their first resource
+			request.StartIndex = types.Int(*request.StartIndex + len(response.Resources))
+			pagesLeft = len(response.Resources) > 0 && *request.StartIndex <= types.IntValue(response.TotalResults)
+		}
+	}
+
+	return nil, nil
+}
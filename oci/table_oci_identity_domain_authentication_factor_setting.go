@@ -0,0 +1,221 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainAuthenticationFactorSetting(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_domain_authentication_factor_setting",
+		Description: "OCI Identity Domain Authentication Factor Setting",
+		List: &plugin.ListConfig{
+			Hydrate:    listIdentityDomainAuthenticationFactorSettings,
+			Tags:       map[string]string{"service": "identitydomains", "action": "ListAuthenticationFactorSettings"},
+			KeyColumns: identityDomainScimKeyColumns(nil),
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the authentication factor settings in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "ocid",
+				Description: "The OCID of the authentication factor settings.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the authentication factor settings were created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.Created"),
+			},
+			{
+				Name:        "time_last_modified",
+				Description: "The date and time the authentication factor settings were last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.LastModified"),
+			},
+			{
+				Name:        "mfa_enabled_category",
+				Description: "The users multi-factor authentication is required for, i.e. NONE, ADMINS_ONLY or ALL.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "mfa_enrollment_type",
+				Description: "Whether enrollment in multi-factor authentication is Required or Optional.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "totp_enabled",
+				Description: "Whether the mobile app one-time passcode factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "push_enabled",
+				Description: "Whether the mobile app notification factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "sms_enabled",
+				Description: "Whether the SMS factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "email_enabled",
+				Description: "Whether the email factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "phone_call_enabled",
+				Description: "Whether the phone call factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "fido_authenticator_enabled",
+				Description: "Whether the FIDO authenticator factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "yubico_otp_enabled",
+				Description: "Whether the Yubico OTP factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "security_questions_enabled",
+				Description: "Whether the security questions factor is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "bypass_code_enabled",
+				Description: "Whether bypass codes are enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "bypass_code_settings",
+				Description: "The settings of the bypass codes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "client_app_settings",
+				Description: "The settings of the mobile authenticator app.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "endpoint_restrictions",
+				Description: "The limits of enrolled devices and failed attempts of the factors.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "totp_settings",
+				Description: "The settings of the one-time passcodes.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "compliance_policy",
+				Description: "The compliance policy of the devices of the mobile authenticator app.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "domain_id",
+				Description: "The OCID of the identity domain of the authentication factor settings.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainOcid"),
+			},
+			{
+				Name:        "domain_name",
+				Description: "The display name of the identity domain of the authentication factor settings.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain of the authentication factor settings.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenancyOcid"),
+			},
+		}),
+	}
+}
+
+type identityDomainAuthenticationFactorSettingInfo struct {
+	identitydomains.AuthenticationFactorSetting
+	DomainName *string
+	DomainUrl  *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainAuthenticationFactorSettings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	domains, err := listIdentityDomainsForQuery(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain_authentication_factor_setting.listIdentityDomainAuthenticationFactorSettings", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		// Create Session
+		session, err := identityDomainsService(ctx, d, *domain.Url)
+		if err != nil {
+			logger.Error("oci_identity_domain_authentication_factor_setting.listIdentityDomainAuthenticationFactorSettings", "connection_error", err)
+			return nil, err
+		}
+
+		// Each domain has a single authentication factor settings resource, so there is nothing to page
+		request := identitydomains.ListAuthenticationFactorSettingsRequest{
+			AttributeSets: []identitydomains.AttributeSetsEnum{identitydomains.AttributeSetsAll},
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		response, err := session.IdentityDomainsClient.ListAuthenticationFactorSettings(ctx, request)
+		if err != nil {
+			logger.Error("oci_identity_domain_authentication_factor_setting.listIdentityDomainAuthenticationFactorSettings", "api_error", err)
+			return nil, err
+		}
+
+		for _, setting := range response.Resources {
+			d.StreamListItem(ctx, identityDomainAuthenticationFactorSettingInfo{setting, domain.DisplayName, domain.Url})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
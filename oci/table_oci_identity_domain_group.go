@@ -0,0 +1,230 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// the columns of oci_identity_domain_group filtered by SCIM attributes
+var identityDomainGroupScimAttributes = []identityDomainScimAttribute{
+	{Column: "id", Attribute: "id"},
+	{Column: "display_name", Attribute: "displayName"},
+	{Column: "external_id", Attribute: "externalId"},
+}
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainGroup(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_domain_group",
+		Description: "OCI Identity Domain Group",
+		List: &plugin.ListConfig{
+			Hydrate:    listIdentityDomainGroups,
+			Tags:       map[string]string{"service": "identitydomains", "action": "ListGroups"},
+			KeyColumns: identityDomainScimKeyColumns(identityDomainGroupScimAttributes),
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The display name of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the group in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "ocid",
+				Description: "The OCID of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionGroupGroup.Description"),
+			},
+			{
+				Name:        "external_id",
+				Description: "The identifier of the group in the system it is provisioned from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the group was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.Created"),
+			},
+			{
+				Name:        "time_last_modified",
+				Description: "The date and time the group was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.LastModified"),
+			},
+			{
+				Name:        "creation_mechanism",
+				Description: "How the group was created, e.g. api, bulk, sync or idcsui.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionGroupGroup.CreationMechanism").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "membership_type",
+				Description: "The membership type of the group, i.e. static or dynamic.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionDynamicGroup.MembershipType").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "membership_rule",
+				Description: "The rule selecting the members of a dynamic group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionDynamicGroup.MembershipRule"),
+			},
+			{
+				Name:        "members",
+				Description: "The members of the group.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "owners",
+				Description: "The owners of the group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionGroupGroup.Owners"),
+			},
+			{
+				Name:        "app_roles",
+				Description: "The application roles granted to the group.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionGroupGroup.AppRoles"),
+			},
+			{
+				Name:        "domain_id",
+				Description: "The OCID of the identity domain of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainOcid"),
+			},
+			{
+				Name:        "domain_name",
+				Description: "The display name of the identity domain of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainDefinedTags),
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainFreeformTags),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenancyOcid"),
+			},
+		}),
+	}
+}
+
+type identityDomainGroupInfo struct {
+	identitydomains.Group
+	DomainName *string
+	DomainUrl  *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainGroups(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	domains, err := listIdentityDomainsForQuery(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain_group.listIdentityDomainGroups", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		// Create Session
+		session, err := identityDomainsService(ctx, d, *domain.Url)
+		if err != nil {
+			logger.Error("oci_identity_domain_group.listIdentityDomainGroups", "connection_error", err)
+			return nil, err
+		}
+
+		request := identitydomains.ListGroupsRequest{
+			Filter:        buildIdentityDomainScimFilter(d, identityDomainGroupScimAttributes),
+			AttributeSets: []identitydomains.AttributeSetsEnum{identitydomains.AttributeSetsAll},
+			StartIndex:    types.Int(1),
+			Count:         types.Int(identityDomainScimCount(d)),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.IdentityDomainsClient.ListGroups(ctx, request)
+			if err != nil {
+				logger.Error("oci_identity_domain_group.listIdentityDomainGroups", "api_error", err)
+				return nil, err
+			}
+
+			for _, group := range response.Resources {
+				d.StreamListItem(ctx, identityDomainGroupInfo{group, domain.DisplayName, domain.Url})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// SCIM pages are addressed by the 1-based index of their first resource
+			request.StartIndex = types.Int(*request.StartIndex + len(response.Resources))
+			pagesLeft = len(response.Resources) > 0 && *request.StartIndex <= types.IntValue(response.TotalResults)
+		}
+	}
+
+	return nil, nil
+}
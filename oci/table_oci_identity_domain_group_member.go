@@ -0,0 +1,209 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// the columns of oci_identity_domain_group_member filtered by SCIM attributes of the group
+var identityDomainGroupMemberScimAttributes = []identityDomainScimAttribute{
+	{Column: "group_id", Attribute: "id"},
+	{Column: "group_name", Attribute: "displayName"},
+}
+
+// the attributes of the groups needed by oci_identity_domain_group_member
+const identityDomainGroupMemberAttributes = "id,displayName,members,domainOcid,compartmentOcid,tenancyOcid"
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainGroupMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_domain_group_member",
+		Description: "OCI Identity Domain Group Member",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDomainGroupMembers,
+			Tags:    map[string]string{"service": "identitydomains", "action": "ListGroups"},
+			KeyColumns: append(identityDomainScimKeyColumns(identityDomainGroupMemberScimAttributes),
+				&plugin.KeyColumn{
+					Name:    "member_id",
+					Require: plugin.Optional,
+				},
+			),
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "group_name",
+				Description: "The display name of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group.DisplayName"),
+			},
+			{
+				Name:        "group_id",
+				Description: "The SCIM identifier of the group in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group.Id"),
+			},
+			{
+				Name:        "member_id",
+				Description: "The SCIM identifier of the member in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Value"),
+			},
+			{
+				Name:        "member_ocid",
+				Description: "The OCID of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Ocid"),
+			},
+			{
+				Name:        "member_name",
+				Description: "The name of the member, i.e. the user name of a user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Name"),
+			},
+			{
+				Name:        "member_display_name",
+				Description: "The display name of the member.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Display"),
+			},
+			{
+				Name:        "member_type",
+				Description: "The type of the member, i.e. User.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Type").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "membership_ocid",
+				Description: "The OCID of the membership.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.MembershipOcid"),
+			},
+			{
+				Name:        "date_added",
+				Description: "The date and time the member was added to the group.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Member.DateAdded"),
+			},
+			{
+				Name:        "domain_id",
+				Description: "The OCID of the identity domain of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group.DomainOcid"),
+			},
+			{
+				Name:        "domain_name",
+				Description: "The display name of the identity domain of the group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain of the group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Member.Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group.CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Group.TenancyOcid"),
+			},
+		}),
+	}
+}
+
+type identityDomainGroupMemberInfo struct {
+	Group      identitydomains.Group
+	Member     identitydomains.GroupMembers
+	DomainName *string
+	DomainUrl  *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainGroupMembers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	domains, err := listIdentityDomainsForQuery(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain_group_member.listIdentityDomainGroupMembers", "api_error", err)
+		return nil, err
+	}
+
+	// Only list the groups the member belongs to
+	var filters []string
+	memberId := d.EqualsQualString("member_id")
+	if memberId != "" {
+		filters = append(filters, "members.value eq "+identityDomainScimString(memberId))
+	}
+
+	for _, domain := range domains {
+		// Create Session
+		session, err := identityDomainsService(ctx, d, *domain.Url)
+		if err != nil {
+			logger.Error("oci_identity_domain_group_member.listIdentityDomainGroupMembers", "connection_error", err)
+			return nil, err
+		}
+
+		request := identitydomains.ListGroupsRequest{
+			Filter:     buildIdentityDomainScimFilter(d, identityDomainGroupMemberScimAttributes, filters...),
+			Attributes: types.String(identityDomainGroupMemberAttributes),
+			StartIndex: types.Int(1),
+			Count:      types.Int(identityDomainScimPageSize),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.IdentityDomainsClient.ListGroups(ctx, request)
+			if err != nil {
+				logger.Error("oci_identity_domain_group_member.listIdentityDomainGroupMembers", "api_error", err)
+				return nil, err
+			}
+
+			for _, group := range response.Resources {
+				for _, member := range group.Members {
+					if memberId != "" && types.SafeString(member.Value) != memberId {
+						continue
+					}
+					d.StreamListItem(ctx, identityDomainGroupMemberInfo{group, member, domain.DisplayName, domain.Url})
+
+					// Context can be cancelled due to manual cancellation or the limit has been hit
+					if d.RowsRemaining(ctx) == 0 {
+						return nil, nil
+					}
+				}
+			}
+
+			// SCIM pages are addressed by the 1-based index of their first resource
+			request.StartIndex = types.Int(*request.StartIndex + len(response.Resources))
+			pagesLeft = len(response.Resources) > 0 && *request.StartIndex <= types.IntValue(response.TotalResults)
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,278 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// the columns of oci_identity_domain_password_policy filtered by SCIM attributes
+var identityDomainPasswordPolicyScimAttributes = []identityDomainScimAttribute{
+	{Column: "id", Attribute: "id"},
+	{Column: "name", Attribute: "name"},
+}
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainPasswordPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_domain_password_policy",
+		Description: "OCI Identity Domain Password Policy",
+		List: &plugin.ListConfig{
+			Hydrate:    listIdentityDomainPasswordPolicies,
+			Tags:       map[string]string{"service": "identitydomains", "action": "ListPasswordPolicies"},
+			KeyColumns: identityDomainScimKeyColumns(identityDomainPasswordPolicyScimAttributes),
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the password policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the password policy in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "ocid",
+				Description: "The OCID of the password policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the password policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the password policy was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.Created"),
+			},
+			{
+				Name:        "time_last_modified",
+				Description: "The date and time the password policy was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.LastModified"),
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the password policy, the policy with the lowest value applying to users in several groups.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "password_strength",
+				Description: "The strength of the password policy, i.e. Simple, Standard or Custom.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PasswordStrength").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "min_length",
+				Description: "The minimum length of the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_length",
+				Description: "The maximum length of the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_lower_case",
+				Description: "The minimum number of lower case letters in the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_upper_case",
+				Description: "The minimum number of upper case letters in the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_numerals",
+				Description: "The minimum number of digits in the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_special_chars",
+				Description: "The minimum number of special characters in the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_unique_chars",
+				Description: "The minimum number of unique characters in the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_repeated_chars",
+				Description: "The maximum number of repeated characters in the password.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "password_expires_after",
+				Description: "The number of days after which the password expires.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "password_expire_warning",
+				Description: "The number of days before expiry users are warned their password expires.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "min_password_age",
+				Description: "The minimum number of days before the password can be changed again.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "num_passwords_in_history",
+				Description: "The number of previous passwords which can't be reused.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "max_incorrect_attempts",
+				Description: "The maximum number of incorrect attempts before the user is locked.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "lockout_duration",
+				Description: "The duration the user is locked for after too many incorrect attempts, in minutes.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "user_name_disallowed",
+				Description: "Whether the password can't contain the user name.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "first_name_disallowed",
+				Description: "Whether the password can't contain the first name of the user.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "last_name_disallowed",
+				Description: "Whether the password can't contain the last name of the user.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "dictionary_word_disallowed",
+				Description: "Whether the password can't be a dictionary word.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "force_password_reset",
+				Description: "Whether users must reset their password after it is set by an administrator.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "groups",
+				Description: "The groups the password policy applies to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "domain_id",
+				Description: "The OCID of the identity domain of the password policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainOcid"),
+			},
+			{
+				Name:        "domain_name",
+				Description: "The display name of the identity domain of the password policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain of the password policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenancyOcid"),
+			},
+		}),
+	}
+}
+
+type identityDomainPasswordPolicyInfo struct {
+	identitydomains.PasswordPolicy
+	DomainName *string
+	DomainUrl  *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainPasswordPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	domains, err := listIdentityDomainsForQuery(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain_password_policy.listIdentityDomainPasswordPolicies", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		// Create Session
+		session, err := identityDomainsService(ctx, d, *domain.Url)
+		if err != nil {
+			logger.Error("oci_identity_domain_password_policy.listIdentityDomainPasswordPolicies", "connection_error", err)
+			return nil, err
+		}
+
+		request := identitydomains.ListPasswordPoliciesRequest{
+			Filter:        buildIdentityDomainScimFilter(d, identityDomainPasswordPolicyScimAttributes),
+			AttributeSets: []identitydomains.AttributeSetsEnum{identitydomains.AttributeSetsAll},
+			StartIndex:    types.Int(1),
+			Count:         types.Int(identityDomainScimCount(d)),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.IdentityDomainsClient.ListPasswordPolicies(ctx, request)
+			if err != nil {
+				logger.Error("oci_identity_domain_password_policy.listIdentityDomainPasswordPolicies", "api_error", err)
+				return nil, err
+			}
+
+			for _, policy := range response.Resources {
+				d.StreamListItem(ctx, identityDomainPasswordPolicyInfo{policy, domain.DisplayName, domain.Url})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// SCIM pages are addressed by the 1-based index of their first resource
+			request.StartIndex = types.Int(*request.StartIndex + len(response.Resources))
+			pagesLeft = len(response.Resources) > 0 && *request.StartIndex <= types.IntValue(response.TotalResults)
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,181 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// the columns of oci_identity_domain_sign_on_policy filtered by SCIM attributes
+var identityDomainSignOnPolicyScimAttributes = []identityDomainScimAttribute{
+	{Column: "id", Attribute: "id"},
+	{Column: "name", Attribute: "name"},
+	{Column: "active", Attribute: "active"},
+}
+
+// identityDomainSignOnPolicyFilter restricts the policies of a domain to sign-on policies
+const identityDomainSignOnPolicyFilter = `policyType.value eq "SignOn"`
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainSignOnPolicy(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_domain_sign_on_policy",
+		Description: "OCI Identity Domain Sign-On Policy",
+		List: &plugin.ListConfig{
+			Hydrate:    listIdentityDomainSignOnPolicies,
+			Tags:       map[string]string{"service": "identitydomains", "action": "ListPolicies"},
+			KeyColumns: identityDomainScimKeyColumns(identityDomainSignOnPolicyScimAttributes),
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "name",
+				Description: "The name of the sign-on policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the sign-on policy in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "ocid",
+				Description: "The OCID of the sign-on policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the sign-on policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Whether the sign-on policy is active.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the sign-on policy was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.Created"),
+			},
+			{
+				Name:        "time_last_modified",
+				Description: "The date and time the sign-on policy was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.LastModified"),
+			},
+			{
+				Name:        "rules",
+				Description: "The rules of the sign-on policy, in the order they are evaluated.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "domain_id",
+				Description: "The OCID of the identity domain of the sign-on policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainOcid"),
+			},
+			{
+				Name:        "domain_name",
+				Description: "The display name of the identity domain of the sign-on policy.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain of the sign-on policy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenancyOcid"),
+			},
+		}),
+	}
+}
+
+type identityDomainSignOnPolicyInfo struct {
+	identitydomains.Policy
+	DomainName *string
+	DomainUrl  *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainSignOnPolicies(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	domains, err := listIdentityDomainsForQuery(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain_sign_on_policy.listIdentityDomainSignOnPolicies", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		// Create Session
+		session, err := identityDomainsService(ctx, d, *domain.Url)
+		if err != nil {
+			logger.Error("oci_identity_domain_sign_on_policy.listIdentityDomainSignOnPolicies", "connection_error", err)
+			return nil, err
+		}
+
+		request := identitydomains.ListPoliciesRequest{
+			Filter:        buildIdentityDomainScimFilter(d, identityDomainSignOnPolicyScimAttributes, identityDomainSignOnPolicyFilter),
+			AttributeSets: []identitydomains.AttributeSetsEnum{identitydomains.AttributeSetsAll},
+			StartIndex:    types.Int(1),
+			Count:         types.Int(identityDomainScimCount(d)),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.IdentityDomainsClient.ListPolicies(ctx, request)
+			if err != nil {
+				logger.Error("oci_identity_domain_sign_on_policy.listIdentityDomainSignOnPolicies", "api_error", err)
+				return nil, err
+			}
+
+			for _, policy := range response.Resources {
+				d.StreamListItem(ctx, identityDomainSignOnPolicyInfo{policy, domain.DisplayName, domain.Url})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// SCIM pages are addressed by the 1-based index of their first resource
+			request.StartIndex = types.Int(*request.StartIndex + len(response.Resources))
+			pagesLeft = len(response.Resources) > 0 && *request.StartIndex <= types.IntValue(response.TotalResults)
+		}
+	}
+
+	return nil, nil
+}
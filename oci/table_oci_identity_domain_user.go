@@ -0,0 +1,304 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identitydomains"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// the columns of oci_identity_domain_user filtered by SCIM attributes
+var identityDomainUserScimAttributes = []identityDomainScimAttribute{
+	{Column: "id", Attribute: "id"},
+	{Column: "user_name", Attribute: "userName"},
+	{Column: "display_name", Attribute: "displayName"},
+	{Column: "external_id", Attribute: "externalId"},
+	{Column: "active", Attribute: "active"},
+	{Column: "user_type", Attribute: "userType"},
+}
+
+//// TABLE DEFINITION
+
+func tableIdentityDomainUser(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_domain_user",
+		Description: "OCI Identity Domain User",
+		List: &plugin.ListConfig{
+			Hydrate:    listIdentityDomainUsers,
+			Tags:       map[string]string{"service": "identitydomains", "action": "ListUsers"},
+			KeyColumns: identityDomainScimKeyColumns(identityDomainUserScimAttributes),
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "user_name",
+				Description: "The user name, which is the unique identifier of the user in the domain.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The SCIM identifier of the user in the domain.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "ocid",
+				Description: "The OCID of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "display_name",
+				Description: "The display name of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "active",
+				Description: "Whether the user is active.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "user_type",
+				Description: "The type of the user, e.g. Employee or Contractor.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserType").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "external_id",
+				Description: "The identifier of the user in the system it is provisioned from.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the user was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.Created"),
+			},
+			{
+				Name:        "time_last_modified",
+				Description: "The date and time the user was last modified.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Meta.LastModified"),
+			},
+			{
+				Name:        "primary_email",
+				Description: "The primary email address of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(identityDomainUserPrimaryEmail),
+			},
+			{
+				Name:        "is_federated_user",
+				Description: "Whether the user authenticates with an external identity provider.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionUserUser.IsFederatedUser"),
+			},
+			{
+				Name:        "is_locked",
+				Description: "Whether the user is locked, e.g. after too many failed login attempts.",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionUserStateUser.Locked.On"),
+			},
+			{
+				Name:        "mfa_status",
+				Description: "The multi-factor authentication status of the user, i.e. ENROLLED, IGNORED or DISABLED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionMfaUser.MfaStatus").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "preferred_authentication_factor",
+				Description: "The preferred authentication factor of the user, e.g. TOTP or PUSH.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionMfaUser.PreferredAuthenticationFactor").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "last_successful_login_date",
+				Description: "The date and time of the last successful login of the user.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionUserStateUser.LastSuccessfulLoginDate"),
+			},
+			{
+				Name:        "password_last_set_date",
+				Description: "The date and time the password of the user was last set.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionPasswordStateUser.LastSuccessfulSetDate"),
+			},
+			{
+				Name:        "name",
+				Description: "The components of the name of the user, e.g. the given name and family name.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "emails",
+				Description: "The email addresses of the user.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "phone_numbers",
+				Description: "The phone numbers of the user.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "groups",
+				Description: "The groups the user is a member of.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "roles",
+				Description: "The roles of the user.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "user_extension",
+				Description: "The Oracle extension of the user, with the status, the provider and the creation mechanism of the user.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionUserUser"),
+			},
+			{
+				Name:        "mfa_extension",
+				Description: "The multi-factor authentication extension of the user, with the enrolled devices.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionMfaUser"),
+			},
+			{
+				Name:        "domain_id",
+				Description: "The OCID of the identity domain of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DomainOcid"),
+			},
+			{
+				Name:        "domain_name",
+				Description: "The display name of the identity domain of the user.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "domain_url",
+				Description: "The URL of the identity domain of the user.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromCamel(),
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainDefinedTags),
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainFreeformTags),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("UrnIetfParamsScimSchemasOracleIdcsExtensionOciTags").Transform(identityDomainTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("UserName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentOcid"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenancyOcid"),
+			},
+		}),
+	}
+}
+
+type identityDomainUserInfo struct {
+	identitydomains.User
+	DomainName *string
+	DomainUrl  *string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDomainUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	domains, err := listIdentityDomainsForQuery(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_domain_user.listIdentityDomainUsers", "api_error", err)
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		// Create Session
+		session, err := identityDomainsService(ctx, d, *domain.Url)
+		if err != nil {
+			logger.Error("oci_identity_domain_user.listIdentityDomainUsers", "connection_error", err)
+			return nil, err
+		}
+
+		request := identitydomains.ListUsersRequest{
+			Filter:        buildIdentityDomainScimFilter(d, identityDomainUserScimAttributes),
+			AttributeSets: []identitydomains.AttributeSetsEnum{identitydomains.AttributeSetsAll},
+			StartIndex:    types.Int(1),
+			Count:         types.Int(identityDomainScimCount(d)),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.IdentityDomainsClient.ListUsers(ctx, request)
+			if err != nil {
+				logger.Error("oci_identity_domain_user.listIdentityDomainUsers", "api_error", err)
+				return nil, err
+			}
+
+			for _, user := range response.Resources {
+				d.StreamListItem(ctx, identityDomainUserInfo{user, domain.DisplayName, domain.Url})
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// SCIM pages are addressed by the 1-based index of their first resource
+			request.StartIndex = types.Int(*request.StartIndex + len(response.Resources))
+			pagesLeft = len(response.Resources) > 0 && *request.StartIndex <= types.IntValue(response.TotalResults)
+		}
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func identityDomainUserPrimaryEmail(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	user := d.HydrateItem.(identityDomainUserInfo)
+	for _, email := range user.Emails {
+		if email.Primary != nil && *email.Primary {
+			return email.Value, nil
+		}
+	}
+	return nil, nil
+}
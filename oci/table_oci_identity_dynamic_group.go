@@ -20,9 +20,11 @@ func tableIdentityDynamicGroup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getIdentityDynamicGroup,
+			Tags:       map[string]string{"service": "identity", "action": "GetDynamicGroup"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listIdentityDynamicGroups,
+			Tags:    map[string]string{"service": "identity", "action": "ListDynamicGroups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "lifecycle_state",
@@ -67,6 +69,18 @@ func tableIdentityDynamicGroup(_ context.Context) *plugin.Table {
 				Description: "A rule string that defines which instance certificates will be matched.",
 				Type:        proto.ColumnType_STRING,
 			},
+			{
+				Name:        "matching_rule_parsed",
+				Description: "The matching rule parsed into nested all and any rules, and conditions on an attribute such as instance.compartment.id, resource.type or tag.<namespace>.<key>.value.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(dynamicGroupMatchingRule),
+			},
+			{
+				Name:        "matching_rule_parse_error",
+				Description: "The reason why the matching rule could not be parsed.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.From(dynamicGroupMatchingRuleError),
+			},
 			{
 				Name:        "time_created",
 				Description: "Date and time the group was created, in the format defined by RFC3339.",
@@ -235,3 +249,28 @@ func dynamicGroupTags(_ context.Context, d *transform.TransformData) (interface{
 
 	return tags, nil
 }
+
+func dynamicGroupMatchingRule(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	dynamicGroup := d.HydrateItem.(identity.DynamicGroup)
+	if dynamicGroup.MatchingRule == nil {
+		return nil, nil
+	}
+
+	rule, err := parseDynamicGroupRule(*dynamicGroup.MatchingRule)
+	if err != nil {
+		return nil, nil
+	}
+	return rule, nil
+}
+
+func dynamicGroupMatchingRuleError(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	dynamicGroup := d.HydrateItem.(identity.DynamicGroup)
+	if dynamicGroup.MatchingRule == nil {
+		return nil, nil
+	}
+
+	if _, err := parseDynamicGroupRule(*dynamicGroup.MatchingRule); err != nil {
+		return err.Error(), nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,364 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/oracle/oci-go-sdk/v65/functions"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	dynamicGroupMemberInstance = "instance"
+	dynamicGroupMemberFunction = "fnfunc"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityDynamicGroupMember(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_dynamic_group_member",
+		Description: "OCI Identity Dynamic Group Member",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityDynamicGroupMembers,
+			Tags:    map[string]string{"service": "identity", "action": "ListDynamicGroups"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "dynamic_group_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "dynamic_group_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "member_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "dynamic_group_name",
+				Description: "The name of the dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "dynamic_group_id",
+				Description: "The OCID of the dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_type",
+				Description: "The type of the member, i.e. instance for compute instances, or the resource type of other resources, e.g. fnfunc for functions.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_id",
+				Description: "The OCID of the member.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "member_name",
+				Description: "The display name of the member.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current state of the member.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "matching_rule",
+				Description: "The matching rule of the dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MemberName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+// dynamicGroupWithRule is a dynamic group with its parsed matching rule
+type dynamicGroupWithRule struct {
+	Group identity.DynamicGroup
+	Rule  *dynamicGroupRule
+}
+
+type dynamicGroupMemberInfo struct {
+	DynamicGroupId   *string
+	DynamicGroupName *string
+	MatchingRule     *string
+	MemberType       string
+	MemberId         *string
+	MemberName       *string
+	LifecycleState   string
+	CompartmentId    *string
+	Region           string
+}
+
+//// LIST FUNCTION
+
+func listIdentityDynamicGroupMembers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	compartment := d.EqualsQualString(matrixKeyCompartment)
+	logger.Debug("oci.listIdentityDynamicGroupMembers", "Compartment", compartment, "OCI_REGION", region)
+
+	// Return nil, if given compartment_id doesn't match
+	if d.EqualsQuals["compartment_id"] != nil && compartment != d.EqualsQualString("compartment_id") {
+		return nil, nil
+	}
+
+	allGroups, err := listDynamicGroupRules(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+		return nil, err
+	}
+
+	var groups []dynamicGroupWithRule
+	for _, group := range allGroups {
+		if d.EqualsQuals["dynamic_group_id"] != nil && d.EqualsQualString("dynamic_group_id") != *group.Group.Id {
+			continue
+		}
+		if d.EqualsQuals["dynamic_group_name"] != nil && d.EqualsQualString("dynamic_group_name") != types.SafeString(group.Group.Name) {
+			continue
+		}
+		groups = append(groups, group)
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	// streamMembers streams a row for each dynamic group the resource is a member of, and returns false once
+	// the limit has been hit
+	streamMembers := func(resource *dynamicGroupResource, name *string, lifecycleState string) bool {
+		for _, group := range groups {
+			if !group.Rule.matches(resource) {
+				continue
+			}
+			d.StreamListItem(ctx, dynamicGroupMemberInfo{
+				DynamicGroupId:   group.Group.Id,
+				DynamicGroupName: group.Group.Name,
+				MatchingRule:     group.Group.MatchingRule,
+				MemberType:       resource.Type,
+				MemberId:         types.String(resource.Id),
+				MemberName:       name,
+				LifecycleState:   lifecycleState,
+				CompartmentId:    types.String(resource.CompartmentId),
+				Region:           region,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return false
+			}
+		}
+		return true
+	}
+
+	memberType := d.EqualsQualString("member_type")
+
+	if memberType == "" || memberType == dynamicGroupMemberInstance {
+		session, err := coreComputeService(ctx, d, region)
+		if err != nil {
+			logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "connection_error", err)
+			return nil, err
+		}
+
+		request := core.ListInstancesRequest{
+			CompartmentId: types.String(compartment),
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.ComputeClient.ListInstances(ctx, request)
+			if err != nil {
+				logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+				return nil, err
+			}
+
+			for _, instance := range response.Items {
+				if instance.LifecycleState == core.InstanceLifecycleStateTerminated {
+					continue
+				}
+				resource := &dynamicGroupResource{
+					Type:          dynamicGroupMemberInstance,
+					Id:            *instance.Id,
+					CompartmentId: *instance.CompartmentId,
+					DefinedTags:   instance.DefinedTags,
+				}
+				if !streamMembers(resource, instance.DisplayName, string(instance.LifecycleState)) {
+					return nil, nil
+				}
+			}
+			if response.OpcNextPage != nil {
+				request.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	if memberType == "" || memberType == dynamicGroupMemberFunction {
+		session, err := functionsManagementService(ctx, d, region)
+		if err != nil {
+			logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "connection_error", err)
+			return nil, err
+		}
+
+		var applications []functions.ApplicationSummary
+		applicationsRequest := functions.ListApplicationsRequest{
+			CompartmentId: types.String(compartment),
+			Limit:         types.Int(50),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.FunctionsManagementClient.ListApplications(ctx, applicationsRequest)
+			if err != nil {
+				logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+				return nil, err
+			}
+			applications = append(applications, response.Items...)
+			if response.OpcNextPage != nil {
+				applicationsRequest.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+
+		for _, application := range applications {
+			request := functions.ListFunctionsRequest{
+				ApplicationId: application.Id,
+				Limit:         types.Int(50),
+				RequestMetadata: common.RequestMetadata{
+					RetryPolicy: getDefaultRetryPolicy(d.Connection),
+				},
+			}
+
+			pagesLeft := true
+			for pagesLeft {
+				response, err := session.FunctionsManagementClient.ListFunctions(ctx, request)
+				if err != nil {
+					logger.Error("oci_identity_dynamic_group_member.listIdentityDynamicGroupMembers", "api_error", err)
+					return nil, err
+				}
+
+				for _, function := range response.Items {
+					if function.LifecycleState == functions.FunctionLifecycleStateDeleted {
+						continue
+					}
+					resource := &dynamicGroupResource{
+						Type:          dynamicGroupMemberFunction,
+						Id:            *function.Id,
+						CompartmentId: *function.CompartmentId,
+						DefinedTags:   function.DefinedTags,
+					}
+					if !streamMembers(resource, function.DisplayName, string(function.LifecycleState)) {
+						return nil, nil
+					}
+				}
+				if response.OpcNextPage != nil {
+					request.Page = response.OpcNextPage
+				} else {
+					pagesLeft = false
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// listDynamicGroupRules returns the active dynamic groups of the tenancy whose matching rule can be parsed
+func listDynamicGroupRules(ctx context.Context, d *plugin.QueryData) ([]dynamicGroupWithRule, error) {
+	cacheKey := "listDynamicGroupRules"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]dynamicGroupWithRule), nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := identity.ListDynamicGroupsRequest{
+		CompartmentId:  &session.TenancyID,
+		Limit:          types.Int(1000),
+		LifecycleState: identity.DynamicGroupLifecycleStateActive,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	var groups []dynamicGroupWithRule
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListDynamicGroups(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range response.Items {
+			rule, err := parseDynamicGroupRule(types.SafeString(group.MatchingRule))
+			if err != nil {
+				plugin.Logger(ctx).Warn("listDynamicGroupRules", "matching rule can't be parsed", *group.Id, "error", err)
+				continue
+			}
+			groups = append(groups, dynamicGroupWithRule{group, rule})
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, groups)
+
+	return groups, nil
+}
@@ -0,0 +1,538 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+const (
+	effectivePermissionPrincipalUser         = "user"
+	effectivePermissionPrincipalDynamicGroup = "dynamic-group"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityEffectivePermission(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_effective_permission",
+		Description: "OCI Identity Effective Permission",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityEffectivePermissions,
+			Tags:    map[string]string{"service": "identity", "action": "ListPolicies"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "principal_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "principal_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			// top columns
+			{
+				Name:        "principal_type",
+				Description: "The type of the principal, i.e. user or dynamic-group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_id",
+				Description: "The OCID of the user or dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "principal_name",
+				Description: "The name of the user or dynamic group.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "effect",
+				Description: "Whether the statement grants or denies the permissions, i.e. allow or deny.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "verb",
+				Description: "The verb granted by the statement, i.e. inspect, read, use or manage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.Verb").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "implied_verbs",
+				Description: "The verb granted by the statement and the verbs it includes, e.g. inspect and read for read.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource",
+				Description: "The resource type or family the statement applies to, e.g. instances, instance-family or all-resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.Resource").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "resource_types",
+				Description: "The resource types of the resource family the statement applies to, or the resource type itself.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "permissions",
+				Description: "The permissions granted or denied by the statement, i.e. the listed permissions or the permissions of the implied verbs on the resource types, e.g. INSTANCE_INSPECT and INSTANCE_READ for read instances.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "listed_permissions",
+				Description: "The permissions listed by the statement instead of a verb and resource type, e.g. USER_INSPECT.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("Statement.Permissions"),
+			},
+			{
+				Name:        "conditions",
+				Description: "The conditions of the where clause of the statement. The conditions are not evaluated.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.Conditions").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "compartment_path",
+				Description: "The path of the compartment, e.g. root/network/prod.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentPath").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "is_inherited",
+				Description: "True if the statement applies to an ancestor of the compartment and is inherited by it.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "target_compartment_id",
+				Description: "The OCID of the compartment the statement applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subject_type",
+				Description: "The type of the subject of the statement matching the principal, i.e. group, dynamic-group, any-user or any-group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Subject.Type"),
+			},
+			{
+				Name:        "subject_name",
+				Description: "The name of the group or dynamic group of the statement matching the principal.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Subject.Name").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "subject_id",
+				Description: "The OCID of the group or dynamic group of the statement matching the principal, when it is referenced by OCID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Subject.Id").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "policy_id",
+				Description: "The OCID of the policy containing the statement.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.PolicyId"),
+			},
+			{
+				Name:        "policy_name",
+				Description: "The name of the policy containing the statement.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.PolicyName"),
+			},
+			{
+				Name:        "policy_compartment_id",
+				Description: "The OCID of the compartment the policy is attached to.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.CompartmentId"),
+			},
+			{
+				Name:        "statement",
+				Description: "The statement, as written in the policy language.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.Statement"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement.Statement"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: "The OCID of the compartment the permissions apply in. If compartment_id is not specified, the compartment the statement applies to.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+// identityEffectivePrincipal is a user or dynamic group, with the groups of a user
+type identityEffectivePrincipal struct {
+	Type   string
+	Id     string
+	Name   string
+	Groups map[string]string
+}
+
+// identityEffectivePermission is a statement granting or denying permissions to a principal in a compartment
+type identityEffectivePermission struct {
+	PrincipalType       string
+	PrincipalId         string
+	PrincipalName       string
+	Effect              string
+	ImpliedVerbs        []string
+	ResourceTypes       []string
+	Permissions         []string
+	CompartmentId       string
+	CompartmentPath     string
+	IsInherited         bool
+	TargetCompartmentId string
+	Subject             identityPolicySubject
+	Statement           *identityPolicyStatement
+}
+
+//// LIST FUNCTION
+
+func listIdentityEffectivePermissions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+
+	compartments, err := listAllCompartments(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+		return nil, err
+	}
+	tree, err := getCompartmentTree(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+		return nil, err
+	}
+
+	// Return nil, if given compartment_id doesn't exist or isn't accessible
+	compartmentId := d.EqualsQualString("compartment_id")
+	if compartmentId != "" {
+		if _, ok := tree.compartments[compartmentId]; !ok {
+			return nil, nil
+		}
+	}
+
+	principals, err := listEffectivePermissionPrincipals(ctx, d)
+	if err != nil {
+		logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+		return nil, err
+	}
+	if len(principals) == 0 {
+		return nil, nil
+	}
+
+	statements, err := listAllPolicyStatements(ctx, d, compartments)
+	if err != nil {
+		logger.Error("oci_identity_effective_permission.listIdentityEffectivePermissions", "api_error", err)
+		return nil, err
+	}
+
+	// The statements of a compartment apply to the compartment and all its descendants
+	var ancestors map[string]bool
+	if compartmentId != "" {
+		ancestors = map[string]bool{}
+		for _, id := range tree.ancestors(compartmentId) {
+			ancestors[id] = true
+		}
+	}
+
+	for _, statement := range statements {
+		if statement.ParseError != "" || (statement.StatementType != policyStatementAllow && statement.StatementType != policyStatementDeny) {
+			continue
+		}
+		target, ok := resolvePolicyLocation(tree, statement)
+		if !ok || (ancestors != nil && !ancestors[target]) {
+			continue
+		}
+
+		row := identityEffectivePermission{
+			Effect:              statement.StatementType,
+			ImpliedVerbs:        statement.ImpliedVerbs(),
+			ResourceTypes:       statement.ResourceTypes(),
+			Permissions:         statement.ImpliedPermissions(),
+			CompartmentId:       target,
+			TargetCompartmentId: target,
+			Statement:           statement,
+		}
+		if compartmentId != "" {
+			row.CompartmentId = compartmentId
+			row.IsInherited = target != compartmentId
+		}
+		row.CompartmentPath = tree.path(row.CompartmentId)
+
+		for _, principal := range principals {
+			subject, ok := matchPolicySubject(statement, principal)
+			if !ok {
+				continue
+			}
+			row.PrincipalType = principal.Type
+			row.PrincipalId = principal.Id
+			row.PrincipalName = principal.Name
+			row.Subject = subject
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// matchPolicySubject returns the first subject of a statement which the principal is, or is a member of.
+// Groups named without an identity domain, or in the Default domain, are matched by name.
+func matchPolicySubject(statement *identityPolicyStatement, principal *identityEffectivePrincipal) (identityPolicySubject, bool) {
+	matchName := func(subject identityPolicySubject, id string, name string) bool {
+		if subject.Id != "" {
+			return subject.Id == id
+		}
+		return (subject.Domain == "" || strings.EqualFold(subject.Domain, "Default")) && strings.EqualFold(subject.Name, name)
+	}
+
+	for _, subject := range statement.Subjects {
+		switch subject.Type {
+		case "any-user":
+			return subject, true
+		case "any-group":
+			if principal.Type == effectivePermissionPrincipalDynamicGroup || len(principal.Groups) > 0 {
+				return subject, true
+			}
+		case "group":
+			for id, name := range principal.Groups {
+				if matchName(subject, id, name) {
+					return subject, true
+				}
+			}
+		case "dynamic-group":
+			if principal.Type == effectivePermissionPrincipalDynamicGroup && matchName(subject, principal.Id, principal.Name) {
+				return subject, true
+			}
+		}
+	}
+	return identityPolicySubject{}, false
+}
+
+// listEffectivePermissionPrincipals returns the users, with their groups, and the dynamic groups of the tenancy
+func listEffectivePermissionPrincipals(ctx context.Context, d *plugin.QueryData) ([]*identityEffectivePrincipal, error) {
+	principalId := d.EqualsQualString("principal_id")
+	principalType := d.EqualsQualString("principal_type")
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var principals []*identityEffectivePrincipal
+
+	if principalType == "" || principalType == effectivePermissionPrincipalUser {
+		var users []identity.User
+		if principalId != "" {
+			if strings.HasPrefix(principalId, "ocid1.user.") {
+				request := identity.GetUserRequest{
+					UserId: types.String(principalId),
+					RequestMetadata: common.RequestMetadata{
+						RetryPolicy: getDefaultRetryPolicy(d.Connection),
+					},
+				}
+				response, err := session.IdentityClient.GetUser(ctx, request)
+				if err != nil {
+					// Return no principals, if given principal_id doesn't exist
+					if isNotFoundError([]string{"404"})(ctx, d, nil, err) {
+						return nil, nil
+					}
+					return nil, err
+				}
+				users = append(users, response.User)
+			}
+		} else {
+			request := identity.ListUsersRequest{
+				CompartmentId: &session.TenancyID,
+				Limit:         types.Int(1000),
+				RequestMetadata: common.RequestMetadata{
+					RetryPolicy: getDefaultRetryPolicy(d.Connection),
+				},
+			}
+			pagesLeft := true
+			for pagesLeft {
+				response, err := session.IdentityClient.ListUsers(ctx, request)
+				if err != nil {
+					return nil, err
+				}
+				users = append(users, response.Items...)
+				if response.OpcNextPage != nil {
+					request.Page = response.OpcNextPage
+				} else {
+					pagesLeft = false
+				}
+			}
+		}
+
+		if len(users) > 0 {
+			groupNames, err := listGroupNames(ctx, d)
+			if err != nil {
+				return nil, err
+			}
+			for _, user := range users {
+				memberships, err := getUserGroups(ctx, d, &plugin.HydrateData{Item: user})
+				if err != nil {
+					return nil, err
+				}
+				principal := &identityEffectivePrincipal{
+					Type:   effectivePermissionPrincipalUser,
+					Id:     *user.Id,
+					Name:   types.SafeString(user.Name),
+					Groups: map[string]string{},
+				}
+				for _, membership := range memberships.([]identity.UserGroupMembership) {
+					groupId := types.SafeString(membership.GroupId)
+					principal.Groups[groupId] = groupNames[groupId]
+				}
+				principals = append(principals, principal)
+			}
+		}
+	}
+
+	if principalType == "" || principalType == effectivePermissionPrincipalDynamicGroup {
+		request := identity.ListDynamicGroupsRequest{
+			CompartmentId: &session.TenancyID,
+			Limit:         types.Int(1000),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+		if principalId == "" || strings.HasPrefix(principalId, "ocid1.dynamicgroup.") {
+			pagesLeft := true
+			for pagesLeft {
+				response, err := session.IdentityClient.ListDynamicGroups(ctx, request)
+				if err != nil {
+					return nil, err
+				}
+				for _, dynamicGroup := range response.Items {
+					if principalId != "" && principalId != *dynamicGroup.Id {
+						continue
+					}
+					principals = append(principals, &identityEffectivePrincipal{
+						Type: effectivePermissionPrincipalDynamicGroup,
+						Id:   *dynamicGroup.Id,
+						Name: types.SafeString(dynamicGroup.Name),
+					})
+				}
+				if response.OpcNextPage != nil {
+					request.Page = response.OpcNextPage
+				} else {
+					pagesLeft = false
+				}
+			}
+		}
+	}
+
+	return principals, nil
+}
+
+// listGroupNames returns the names of the groups of the tenancy, by OCID
+func listGroupNames(ctx context.Context, d *plugin.QueryData) (map[string]string, error) {
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	request := identity.ListGroupsRequest{
+		CompartmentId: &session.TenancyID,
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	names := map[string]string{}
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.IdentityClient.ListGroups(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range response.Items {
+			names[*group.Id] = types.SafeString(group.Name)
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return names, nil
+}
+
+// listAllPolicyStatements returns the parsed statements of the policies of all compartments
+func listAllPolicyStatements(ctx context.Context, d *plugin.QueryData, compartments []identity.Compartment) ([]*identityPolicyStatement, error) {
+	cacheKey := "listAllPolicyStatements"
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.([]*identityPolicyStatement), nil
+	}
+
+	// Create Session
+	session, err := identityService(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []*identityPolicyStatement
+	for _, compartment := range compartments {
+		request := identity.ListPoliciesRequest{
+			CompartmentId:  compartment.Id,
+			Limit:          types.Int(1000),
+			LifecycleState: identity.PolicyLifecycleStateActive,
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		pagesLeft := true
+		for pagesLeft {
+			response, err := session.IdentityClient.ListPolicies(ctx, request)
+			if err != nil {
+				return nil, err
+			}
+			for _, policy := range response.Items {
+				statements = append(statements, getPolicyStatements(policy)...)
+			}
+			if response.OpcNextPage != nil {
+				request.Page = response.OpcNextPage
+			} else {
+				pagesLeft = false
+			}
+		}
+	}
+
+	d.ConnectionManager.Cache.Set(cacheKey, statements)
+
+	return statements, nil
+}
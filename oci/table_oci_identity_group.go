@@ -20,9 +20,11 @@ func tableIdentityGroup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getGroup,
+			Tags:       map[string]string{"service": "identity", "action": "GetGroup"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listGroup,
+			Tags:    map[string]string{"service": "identity", "action": "ListGroups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "lifecycle_state",
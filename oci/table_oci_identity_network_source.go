@@ -20,9 +20,11 @@ func tableIdentityNetworkSource(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getIdentityNetworkSource,
+			Tags:       map[string]string{"service": "identity", "action": "GetNetworkSource"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listIdentityNetworkSources,
+			Tags:    map[string]string{"service": "identity", "action": "ListNetworkSources"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "lifecycle_state",
@@ -21,9 +21,11 @@ func tableIdentityPolicy(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getPolicy,
+			Tags:       map[string]string{"service": "identity", "action": "GetPolicy"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listPolicy,
+			Tags:    map[string]string{"service": "identity", "action": "ListPolicies"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -210,7 +212,7 @@ func getPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	logger.Debug("oci.getPolicy", "Compartment", compartment)
 
 	// Restrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 	id := d.EqualsQuals["id"].GetStringValue()
@@ -0,0 +1,198 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/identity"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableIdentityPolicyStatement(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_identity_policy_statement",
+		Description: "OCI Identity Policy Statement",
+		List: &plugin.ListConfig{
+			ParentHydrate: listPolicy,
+			Hydrate:       listIdentityPolicyStatements,
+			Tags:          map[string]string{"service": "identity", "action": "ListPolicies"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "policy_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartmentList,
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			// top columns
+			{
+				Name:        "policy_name",
+				Description: "The name of the policy containing the statement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "policy_id",
+				Description: "The OCID of the policy containing the statement.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "statement_index",
+				Description: "The position of the statement in the policy, starting at 0.",
+				Type:        proto.ColumnType_INT,
+			},
+			{
+				Name:        "statement",
+				Description: "The statement, as written in the policy language.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "statement_type",
+				Description: "The type of the statement, i.e. allow, deny, define, endorse or admit.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "subject_type",
+				Description: "The type of the first subject of the statement, e.g. group, dynamic-group, service, any-user or any-group. For define statements, the type of the alias, i.e. tenancy, group or dynamic-group.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubjectType").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "subjects",
+				Description: "The subjects of the statement, with their type, identity domain, name and OCID.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "subject_names",
+				Description: "The names of the subjects of the statement.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromMethod("SubjectNames"),
+			},
+			{
+				Name:        "subject_ids",
+				Description: "The OCIDs of the subjects of the statement, when they are referenced by OCID.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromMethod("SubjectIds"),
+			},
+			{
+				Name:        "verb",
+				Description: "The verb granted by the statement, i.e. inspect, read, use or manage.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Verb").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "permissions",
+				Description: "The permissions granted by the statement, when they are listed instead of a verb and resource type, e.g. USER_INSPECT.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resource",
+				Description: "The resource type or family the statement applies to, e.g. instances, instance-family or all-resources.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Resource").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "location_type",
+				Description: "The type of the location the statement applies to, i.e. tenancy, compartment or any-tenancy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LocationType").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "location",
+				Description: "The name or path of the compartment the statement applies to, relative to the compartment of the policy, e.g. Networks:Prod. For endorse statements, the alias of the other tenancy.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Location").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "location_id",
+				Description: "The OCID of the compartment the statement applies to, when it is referenced by OCID.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LocationId").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "conditions",
+				Description: "The conditions of the where clause of the statement.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Conditions").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "tenancy_alias",
+				Description: "The alias of the other tenancy of define, endorse and admit statements.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TenancyAlias").Transform(transform.NullIfZeroValue),
+			},
+			{
+				Name:        "parse_error",
+				Description: "The reason why the statement could not be parsed. The other columns hold the parts parsed before the error.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ParseError").Transform(transform.NullIfZeroValue),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Statement"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentId"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listIdentityPolicyStatements(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy := h.Item.(identity.Policy)
+
+	// Return nil, if given policy_id doesn't match
+	if d.EqualsQuals["policy_id"] != nil && d.EqualsQualString("policy_id") != *policy.Id {
+		return nil, nil
+	}
+
+	for _, statement := range getPolicyStatements(policy) {
+		d.StreamListItem(ctx, statement)
+
+		// Context can be cancelled due to manual cancellation or the limit has been hit
+		if d.RowsRemaining(ctx) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getPolicyStatements parses the statements of a policy
+func getPolicyStatements(policy identity.Policy) []*identityPolicyStatement {
+	var statements []*identityPolicyStatement
+	for i, text := range policy.Statements {
+		statement := parsePolicyStatement(text)
+		statement.PolicyId = policy.Id
+		statement.PolicyName = policy.Name
+		statement.CompartmentId = policy.CompartmentId
+		statement.StatementIndex = i
+		statements = append(statements, statement)
+	}
+	return statements
+}
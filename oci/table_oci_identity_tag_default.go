@@ -21,9 +21,11 @@ func tableIdentityTagDefault(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getIdentityTagDefault,
+			Tags:       map[string]string{"service": "identity", "action": "GetTagDefault"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listIdentityTagDefaults,
+			Tags:    map[string]string{"service": "identity", "action": "ListTagDefaults"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -184,7 +186,7 @@ func getIdentityTagDefault(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	logger.Debug("oci.getIdentityTagDefault", "Compartment", compartment)
 
 	// Rstrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,9 +21,11 @@ func tableIdentityTagNamespace(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getIdentityTagNamespace,
+			Tags:       map[string]string{"service": "identity", "action": "GetTagNamespace"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listIdentityTagNamespaces,
+			Tags:    map[string]string{"service": "identity", "action": "ListTagNamespaces"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -189,7 +191,7 @@ func getIdentityTagNamespace(ctx context.Context, d *plugin.QueryData, h *plugin
 	logger.Debug("oci.getIdentityTagNamespace", "Compartment", compartment)
 
 	// Restrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
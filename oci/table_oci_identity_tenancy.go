@@ -19,11 +19,14 @@ func tableIdentityTenancy(_ context.Context) *plugin.Table {
 		Description: "OCI Identity Tenancy",
 		List: &plugin.ListConfig{
 			Hydrate: listIdentityTenancies,
+			Tags:    map[string]string{"service": "identity", "action": "GetTenancy"},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
-				Func:              getRetentionPeriod,
-				ShouldIgnoreError: isNotFoundError([]string{"404"}),
+				Func: getRetentionPeriod,
+				IgnoreConfig: &plugin.IgnoreConfig{
+					ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+				},
 			},
 		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
@@ -136,8 +139,8 @@ func listIdentityTenancies(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 func getRetentionPeriod(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	plugin.Logger(ctx).Trace("getRetentionPeriod")
 
-	// Create Session
-	session, err := auditService(ctx, d)
+	// Create Session in the default region
+	session, err := auditService(ctx, d, "")
 	if err != nil {
 		return nil, err
 	}
@@ -21,9 +21,11 @@ func tableIdentityUser(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getUser,
+			Tags:       map[string]string{"service": "identity", "action": "GetUser"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listUsers,
+			Tags:    map[string]string{"service": "identity", "action": "ListUsers"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "external_identifier",
@@ -24,6 +24,7 @@ func tableKmsKey(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			ParentHydrate: listKmsVaults,
 			Hydrate:       listKmsKeys,
+			Tags:          map[string]string{"service": "keymanagement", "action": "ListKeys"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "algorithm",
@@ -21,6 +21,7 @@ func tableKmsKeyVersion(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: plugin.AllColumns([]string{"key_id", "management_endpoint", "region"}),
 			Hydrate:    listKmsKeyVersions,
+			Tags:       map[string]string{"service": "keymanagement", "action": "ListKeyVersions"},
 		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
@@ -19,12 +19,16 @@ func tableKmsVault(_ context.Context) *plugin.Table {
 		Name:        "oci_kms_vault",
 		Description: "OCI KMS Vault",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			Hydrate:           getKmsVault,
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getKmsVault,
+			Tags:       map[string]string{"service": "keymanagement", "action": "GetVault"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"}),
+			},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listKmsVaults,
+			Tags:    map[string]string{"service": "keymanagement", "action": "ListVaults"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -215,7 +219,7 @@ func getKmsVault(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 	logger.Debug("oci.getKmsVault", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
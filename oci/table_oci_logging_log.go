@@ -21,10 +21,12 @@ func tableLoggingLog(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.AllColumns([]string{"id", "log_group_id"}),
 			Hydrate:    getLoggingLog,
+			Tags:       map[string]string{"service": "logging", "action": "GetLog"},
 		},
 		List: &plugin.ListConfig{
 			ParentHydrate: listLoggingLogGroups,
 			Hydrate:       listLoggingLogs,
+			Tags:          map[string]string{"service": "logging", "action": "ListLogs"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "lifecycle_state",
@@ -216,7 +218,7 @@ func getLoggingLog(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateDa
 	logger.Debug("getLoggingLog", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -21,9 +21,11 @@ func tableLoggingLogGroup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getLoggingLogGroup,
+			Tags:       map[string]string{"service": "logging", "action": "GetLogGroup"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listLoggingLogGroups,
+			Tags:    map[string]string{"service": "logging", "action": "ListLogGroups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -205,7 +207,7 @@ func getLoggingLogGroup(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 	logger.Debug("getLoggingLogGroup", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
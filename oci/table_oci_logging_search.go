@@ -2,6 +2,11 @@ package oci
 
 import (
 	"context"
+	"encoding/json"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/oracle/oci-go-sdk/v65/common"
@@ -19,9 +24,12 @@ func tableLoggingSearch(_ context.Context) *plugin.Table {
 		Name:        "oci_logging_search",
 		Description: "OCI Logging Search",
 		List: &plugin.ListConfig{
-			Hydrate:           listLoggingSearch,
-			ShouldIgnoreError: isNotFoundError([]string{"404"}),
-			KeyColumns: []*plugin.KeyColumn{
+			Hydrate: listLoggingSearch,
+			Tags:    map[string]string{"service": "loggingsearch", "action": "SearchLogs"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"404"}),
+			},
+			KeyColumns: append([]*plugin.KeyColumn{
 				{
 					Name:       "timestamp",
 					Operators:  []string{">", ">=", "=", "<", "<="},
@@ -43,7 +51,7 @@ func tableLoggingSearch(_ context.Context) *plugin.Table {
 					Require:    plugin.Optional,
 					CacheMatch: "exact",
 				},
-			},
+			}, loggingSearchFieldKeyColumns()...),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: []*plugin.Column{
@@ -89,6 +97,81 @@ func tableLoggingSearch(_ context.Context) *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Transform:   transform.FromQual("search_query"),
 			},
+
+			// VCN flow log columns
+			{
+				Name:        "source_address",
+				Description: "The source IP address of the flow, for VCN flow logs.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Data.logContent.data.sourceAddress").Transform(loggingSearchIPAddress),
+			},
+			{
+				Name:        "source_port",
+				Description: "The source port of the flow, for VCN flow logs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Data.logContent.data.sourcePort"),
+			},
+			{
+				Name:        "destination_address",
+				Description: "The destination IP address of the flow, for VCN flow logs.",
+				Type:        proto.ColumnType_IPADDR,
+				Transform:   transform.FromField("Data.logContent.data.destinationAddress").Transform(loggingSearchIPAddress),
+			},
+			{
+				Name:        "destination_port",
+				Description: "The destination port of the flow, for VCN flow logs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Data.logContent.data.destinationPort"),
+			},
+			{
+				Name:        "protocol",
+				Description: "The IANA protocol number of the flow, e.g. 6 for TCP, for VCN flow logs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Data.logContent.data.protocol"),
+			},
+			{
+				Name:        "action",
+				Description: "Whether the flow was accepted or rejected by the security rules, i.e. ACCEPT or REJECT, for VCN flow logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.logContent.data.action"),
+			},
+			{
+				Name:        "bytes_out",
+				Description: "The number of bytes sent during the capture window, for VCN flow logs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Data.logContent.data.bytesOut"),
+			},
+			{
+				Name:        "packets",
+				Description: "The number of packets sent during the capture window, for VCN flow logs.",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Data.logContent.data.packets"),
+			},
+
+			// audit log columns
+			{
+				Name:        "principal_name",
+				Description: "The name of the user or service issuing the request, for audit logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.logContent.data.identity.principalName"),
+			},
+			{
+				Name:        "event_name",
+				Description: "The name of the API operation that generated the event, e.g. GetInstance, for audit logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.logContent.data.eventName"),
+			},
+			{
+				Name:        "request_path",
+				Description: "The full path of the API request, for audit logs.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Data.logContent.data.request.path"),
+			},
+			{
+				Name:        "is_truncated",
+				Description: "True if OCI Logging returned only part of the log entries matched around the time of the log entry, even after splitting the search down to a one-minute window. Narrow the search query to get the others.",
+				Type:        proto.ColumnType_BOOL,
+			},
 			{
 				Name:        "log_content",
 				Description: "Stores the actual content of the log entry in JSON format. It contains the detailed information about the log event, such as log message, metadata, and any additional structured data.",
@@ -127,8 +210,45 @@ func tableLoggingSearch(_ context.Context) *plugin.Table {
 }
 
 type LoggingSearch struct {
-	Data   interface{}
-	Region string
+	Data        interface{}
+	Region      string
+	IsTruncated bool
+}
+
+const (
+	// long time ranges are split into windows which are searched concurrently
+	loggingSearchWindow         = 24 * time.Hour
+	loggingSearchMinWindow      = time.Minute
+	loggingSearchMaxConcurrency = 4
+
+	// maximum number of results returned by a single search
+	loggingSearchPageSize = 1000
+)
+
+// loggingSearchField is a column parsed from the log content, which can be filtered in the search query
+type loggingSearchField struct {
+	Column  string
+	Field   string
+	Numeric bool
+}
+
+var loggingSearchFields = []loggingSearchField{
+	{Column: "source_address", Field: "data.sourceAddress"},
+	{Column: "source_port", Field: "data.sourcePort", Numeric: true},
+	{Column: "destination_address", Field: "data.destinationAddress"},
+	{Column: "destination_port", Field: "data.destinationPort", Numeric: true},
+	{Column: "protocol", Field: "data.protocol", Numeric: true},
+	{Column: "action", Field: "data.action"},
+	{Column: "bytes_out", Field: "data.bytesOut", Numeric: true},
+	{Column: "packets", Field: "data.packets", Numeric: true},
+	{Column: "principal_name", Field: "data.identity.principalName"},
+	{Column: "event_name", Field: "data.eventName"},
+	{Column: "request_path", Field: "data.request.path"},
+}
+
+type loggingSearchWindowResult struct {
+	Results []LoggingSearch
+	Err     error
 }
 
 //// LIST FUNCTION
@@ -145,32 +265,31 @@ func listLoggingSearch(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 		return nil, err
 	}
 
-	request := loggingsearch.SearchLogsRequest{}
-
 	//set the start and end time based on the provided timestamp
-	var timeStart, timeEnd *common.SDKTime
+	var timeStart, timeEnd *time.Time
 	if d.Quals["timestamp"] != nil {
 		for _, q := range d.Quals["timestamp"].Quals {
 			timestamp := q.Value.GetTimestampValue().AsTime()
 			switch q.Operator {
 			case "=":
-				timeStart = &common.SDKTime{Time: timestamp}
-				timeEnd = &common.SDKTime{Time: timestamp}
+				timeStart = &timestamp
+				timeEnd = &timestamp
 			case ">=", ">":
-				timeStart = &common.SDKTime{Time: timestamp}
+				timeStart = &timestamp
 			case "<", "<=":
-				timeEnd = &common.SDKTime{Time: timestamp}
+				timeEnd = &timestamp
 			}
 		}
 	}
-	if timeStart == nil {
-		timeStart = &common.SDKTime{Time: (time.Now().AddDate(0, 0, -1))}
-	}
 	if timeEnd == nil {
-		timeEnd = &common.SDKTime{Time: (time.Now())}
+		now := time.Now()
+		timeEnd = &now
+	}
+	if timeStart == nil {
+		start := timeEnd.AddDate(0, 0, -1)
+		timeStart = &start
 	}
-	request.SearchLogsDetails.TimeStart = timeStart
-	request.SearchLogsDetails.TimeEnd = timeEnd
+
 	var searchQuery string
 	if d.EqualsQualString("search_query") == "" {
 		log_group_name := d.EqualsQualString("log_group_name")
@@ -185,44 +304,301 @@ func listLoggingSearch(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 			query = query + "/" + log_name
 		}
 		searchQuery = "search \"" + query + "\""
+
+		// filter the log entries in the search instead of in Postgres
+		if where := loggingSearchWhereClause(d); where != "" {
+			searchQuery = searchQuery + " | where " + where
+		}
 	} else {
 		searchQuery = d.EqualsQualString("search_query")
 	}
-	request.SearchLogsDetails.SearchQuery = types.String(searchQuery)
 
-	request.Limit = types.Int(1000)
-	request.RequestMetadata = common.RequestMetadata{
-		RetryPolicy: getDefaultRetryPolicy(d.Connection),
+	// search the windows concurrently, and stream their results in time order
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	windows := splitLoggingSearchTimeRange(*timeStart, *timeEnd, loggingSearchWindow)
+	windowResults := make([]chan loggingSearchWindowResult, len(windows))
+	for i := range windows {
+		windowResults[i] = make(chan loggingSearchWindowResult, loggingSearchMaxConcurrency)
+	}
+
+	go func() {
+		semaphore := make(chan struct{}, loggingSearchMaxConcurrency)
+		for i, window := range windows {
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			go func(i int, window [2]time.Time) {
+				defer func() { <-semaphore }()
+				defer close(windowResults[i])
+
+				send := func(result loggingSearchWindowResult) {
+					select {
+					case windowResults[i] <- result:
+					case <-ctx.Done():
+					}
+				}
+				limit := d.RowsRemaining(ctx)
+				if limit <= 0 {
+					return
+				}
+				err := searchLoggingWindow(ctx, d, session, region, searchQuery, window[0], window[1], limit, func(results []LoggingSearch) {
+					send(loggingSearchWindowResult{Results: results})
+				})
+				if err != nil {
+					send(loggingSearchWindowResult{Err: err})
+				}
+			}(i, window)
+		}
+	}()
+
+	for i := range windows {
+		for {
+			var windowResult loggingSearchWindowResult
+			var ok bool
+			select {
+			case windowResult, ok = <-windowResults[i]:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+			if !ok {
+				break
+			}
+			if windowResult.Err != nil {
+				return nil, windowResult.Err
+			}
+
+			for _, result := range windowResult.Results {
+				d.StreamListItem(ctx, result)
+
+				// Context can be cancelled due to manual cancellation or the limit has been hit
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// searchLoggingWindow searches at most limit results between the start and end time, and streams them in
+// time order. A search returns at most loggingSearchPageSize results, so when the first page is full and
+// more results are needed, the window is split in halves, which are searched and streamed one after the other.
+func searchLoggingWindow(ctx context.Context, d *plugin.QueryData, session *session, region string, searchQuery string, timeStart time.Time, timeEnd time.Time, limit int64, stream func([]LoggingSearch)) error {
+	logger := plugin.Logger(ctx)
+
+	request := loggingsearch.SearchLogsRequest{
+		SearchLogsDetails: loggingsearch.SearchLogsDetails{
+			TimeStart:   &common.SDKTime{Time: timeStart},
+			TimeEnd:     &common.SDKTime{Time: timeEnd},
+			SearchQuery: types.String(searchQuery),
+		},
+		Limit: types.Int(loggingSearchPageSize),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Reduce the page size if the limit is less
+	if limit < int64(*request.Limit) {
+		request.Limit = types.Int(int(limit))
+	}
+
+	response, err := session.LoggingSearchClient.SearchLogs(ctx, request)
+	if err != nil {
+		return err
 	}
 
-	limit := d.QueryContext.Limit
-	if d.QueryContext.Limit != nil {
-		if *limit < int64(*request.Limit) {
-			request.Limit = types.Int(int(*limit))
+	// a full first page means that the results are likely truncated, so search both halves of the window instead
+	if len(response.SearchResponse.Results) >= loggingSearchPageSize && limit > loggingSearchPageSize && timeEnd.Sub(timeStart) > loggingSearchMinWindow {
+		logger.Debug("searchLoggingWindow", "split_window_start", timeStart, "split_window_end", timeEnd)
+
+		var streamed int64
+		countingStream := func(results []LoggingSearch) {
+			streamed += int64(len(results))
+			stream(results)
+		}
+		middle := timeStart.Add(timeEnd.Sub(timeStart) / 2)
+		if err := searchLoggingWindow(ctx, d, session, region, searchQuery, timeStart, middle, limit, countingStream); err != nil {
+			return err
+		}
+		if streamed >= limit || ctx.Err() != nil {
+			return nil
 		}
+		return searchLoggingWindow(ctx, d, session, region, searchQuery, middle, timeEnd, limit-streamed, countingStream)
 	}
 
-	pagesLeft := true
-	for pagesLeft {
-		response, err := session.LoggingSearchClient.SearchLogs(ctx, request)
+	var results []LoggingSearch
+	for {
+		for _, result := range response.SearchResponse.Results {
+			results = append(results, LoggingSearch{Data: *result.Data, Region: region})
+		}
+		if response.OpcNextPage == nil || int64(len(results)) >= limit {
+			break
+		}
+
+		request.Page = response.OpcNextPage
+		response, err = session.LoggingSearchClient.SearchLogs(ctx, request)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		for _, result := range response.SearchResponse.Results {
-			d.StreamListItem(ctx, LoggingSearch{*result.Data, region})
+	}
+
+	// the window can't be split any further, so log entries missing from the results can only be reported:
+	// the search matched more log entries than returned, or the last page is full without a next page
+	lastPageFull := len(response.SearchResponse.Results) >= *request.Limit
+	matched := 0
+	if response.SearchResponse.Summary != nil {
+		matched = types.IntValue(response.SearchResponse.Summary.ResultCount)
+	}
+	if int64(len(results)) < limit && (matched > len(results) || (lastPageFull && response.OpcNextPage == nil)) {
+		logger.Warn("searchLoggingWindow", "truncated_window_start", timeStart, "truncated_window_end", timeEnd, "results", len(results), "matched", matched)
+		for i := range results {
+			results[i].IsTruncated = true
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return loggingSearchDatetime(results[i]) < loggingSearchDatetime(results[j])
+	})
+	if int64(len(results)) > limit {
+		results = results[:limit]
+	}
+	stream(results)
+	return nil
+}
+
+// splitLoggingSearchTimeRange splits the time range in consecutive windows of at most the given duration
+func splitLoggingSearchTimeRange(timeStart time.Time, timeEnd time.Time, window time.Duration) [][2]time.Time {
+	if !timeEnd.After(timeStart) {
+		return [][2]time.Time{{timeStart, timeEnd}}
+	}
+	var windows [][2]time.Time
+	for start := timeStart; start.Before(timeEnd); start = start.Add(window) {
+		end := start.Add(window)
+		if end.After(timeEnd) {
+			end = timeEnd
+		}
+		windows = append(windows, [2]time.Time{start, end})
+	}
+	return windows
+}
+
+// loggingSearchDatetime returns the time of the log entry, in milliseconds since the epoch
+func loggingSearchDatetime(result LoggingSearch) float64 {
+	data, ok := result.Data.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	switch datetime := data["datetime"].(type) {
+	case float64:
+		return datetime
+	case json.Number:
+		value, _ := datetime.Float64()
+		return value
+	}
+	return 0
+}
+
+// loggingSearchFieldKeyColumns returns the key columns of the fields parsed from the log content
+func loggingSearchFieldKeyColumns() []*plugin.KeyColumn {
+	var keyColumns []*plugin.KeyColumn
+	for _, field := range loggingSearchFields {
+		operators := []string{"=", "<>"}
+		if field.Numeric {
+			operators = []string{"=", "<>", ">", ">=", "<", "<="}
+		}
+		keyColumns = append(keyColumns, &plugin.KeyColumn{
+			Name:       field.Column,
+			Operators:  operators,
+			Require:    plugin.Optional,
+			CacheMatch: "exact",
+		})
+	}
+	return keyColumns
+}
 
-			// Context can be cancelled due to manual cancellation or the limit has been hit
-			if d.RowsRemaining(ctx) == 0 {
-				return nil, nil
+// loggingSearchWhereClause builds the conditions of the where clause of the search query from the quals of
+// the fields parsed from the log content, e.g. data.destinationPort = 22 and data.action = 'REJECT'
+func loggingSearchWhereClause(d *plugin.QueryData) string {
+	var conditions []string
+	for _, field := range loggingSearchFields {
+		if d.Quals[field.Column] == nil {
+			continue
+		}
+		for _, q := range d.Quals[field.Column].Quals {
+			operator := q.Operator
+			if operator == "<>" {
+				operator = "!="
+			}
+
+			// an IN list is passed as an = qual with a list value
+			qualValues := []*proto.QualValue{q.Value}
+			if list := q.Value.GetListValue(); list != nil {
+				qualValues = list.Values
+			}
+
+			var fieldConditions []string
+			for _, qualValue := range qualValues {
+				value, ok := loggingSearchValue(field, qualValue)
+				if !ok {
+					// values which cannot be used in the search are only filtered in Postgres
+					fieldConditions = nil
+					break
+				}
+				fieldConditions = append(fieldConditions, field.Field+" "+operator+" "+value)
+			}
+
+			switch {
+			case len(fieldConditions) == 1:
+				conditions = append(conditions, fieldConditions[0])
+			case len(fieldConditions) > 1 && operator == "=":
+				conditions = append(conditions, "("+strings.Join(fieldConditions, " or ")+")")
+			case len(fieldConditions) > 1:
+				conditions = append(conditions, strings.Join(fieldConditions, " and "))
 			}
 		}
+	}
+	return strings.Join(conditions, " and ")
+}
 
-		if response.OpcNextPage != nil {
-			request.Page = response.OpcNextPage
-		} else {
-			pagesLeft = false
+// loggingSearchValue returns the value of a qual formatted for the search query, or false if it cannot be
+// formatted, e.g. a string which cannot be quoted safely
+func loggingSearchValue(field loggingSearchField, qualValue *proto.QualValue) (string, bool) {
+	if field.Numeric {
+		value, ok := qualValue.GetValue().(*proto.QualValue_Int64Value)
+		if !ok {
+			return "", false
 		}
+		return strconv.FormatInt(value.Int64Value, 10), true
 	}
 
-	return nil, err
+	var value string
+	switch v := qualValue.GetValue().(type) {
+	case *proto.QualValue_InetValue:
+		value = v.InetValue.GetAddr()
+	case *proto.QualValue_StringValue:
+		value = v.StringValue
+	default:
+		return "", false
+	}
+	if value == "" || strings.ContainsAny(value, "'\\") {
+		return "", false
+	}
+	return "'" + value + "'", true
+}
+
+//// TRANSFORM FUNCTION
+
+// loggingSearchIPAddress returns nil for the placeholders used instead of IP addresses, e.g. "-"
+func loggingSearchIPAddress(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	address, ok := d.Value.(string)
+	if !ok || net.ParseIP(address) == nil {
+		return nil, nil
+	}
+	return address, nil
 }
@@ -0,0 +1,367 @@
+package oci
+
+import (
+	"context"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMonitoringAlarm(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_monitoring_alarm",
+		Description: "OCI Monitoring Alarm",
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getMonitoringAlarm,
+			Tags:       map[string]string{"service": "monitoring", "action": "GetAlarm"},
+		},
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringAlarms,
+			Tags:    map[string]string{"service": "monitoring", "action": "ListAlarms"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "lifecycle_state",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "A user-friendly name for the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "severity",
+				Description: "The perceived severity of the alarm with regard to the affected system, e.g. CRITICAL, ERROR, WARNING or INFO.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_enabled",
+				Description: "Whether the alarm is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "lifecycle_state",
+				Description: "The current lifecycle state of the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "namespace",
+				Description: "The source service or application emitting the metric that is evaluated by the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The Monitoring Query Language (MQL) expression to evaluate for the alarm, e.g. CpuUtilization[1m].mean() > 75.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "time_created",
+				Description: "The date and time the alarm was created.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getMonitoringAlarm,
+				Transform:   transform.FromField("TimeCreated.Time"),
+			},
+
+			// other columns
+			{
+				Name:        "alarm_summary",
+				Description: "Customizable alarm summary (alarmSummary alarm message parameter).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "body",
+				Description: "The human-readable content of the delivered alarm notification.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMonitoringAlarm,
+			},
+			{
+				Name:        "destinations",
+				Description: "A list of the OCIDs of the notification topics or streams the alarm messages are sent to.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "evaluation_slack_duration",
+				Description: "The time the service waits for the data of the metric before evaluating the alarm, e.g. PT3M.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "is_notifications_per_metric_dimension_enabled",
+				Description: "Whether the alarm sends a separate message for each metric stream.",
+				Type:        proto.ColumnType_BOOL,
+			},
+			{
+				Name:        "message_format",
+				Description: "The format to use for alarm notifications.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMonitoringAlarm,
+			},
+			{
+				Name:        "metric_compartment_id",
+				Description: "The OCID of the compartment containing the metric being evaluated by the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "metric_compartment_id_in_subtree",
+				Description: "Whether the alarm monitors the metrics of all the subcompartments of the metric compartment as well.",
+				Type:        proto.ColumnType_BOOL,
+				Hydrate:     getMonitoringAlarm,
+			},
+			{
+				Name:        "notification_title",
+				Description: "Customizable notification title (title alarm message parameter).",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "notification_version",
+				Description: "The version of the alarm notification to be delivered.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "overrides",
+				Description: "A set of overrides that control evaluations of the alarm.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "pending_duration",
+				Description: "The period of time that the condition defined in the alarm must persist before the alarm state changes from OK to FIRING, e.g. PT5M.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMonitoringAlarm,
+			},
+			{
+				Name:        "repeat_notification_duration",
+				Description: "The frequency at which notifications are re-submitted while the alarm is firing, e.g. PT2H.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMonitoringAlarm,
+			},
+			{
+				Name:        "resolution",
+				Description: "The time between calculated aggregation windows for the alarm, e.g. 1m.",
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getMonitoringAlarm,
+			},
+			{
+				Name:        "resource_group",
+				Description: "Resource group of the metric evaluated by the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "rule_name",
+				Description: "Identifier of the alarm's base values for alarm evaluation, for use when the alarm contains overrides.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "suppression",
+				Description: "The configuration details for suppressing the alarm notifications.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "time_updated",
+				Description: "The date and time the alarm was last updated.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Hydrate:     getMonitoringAlarm,
+				Transform:   transform.FromField("TimeUpdated.Time"),
+			},
+
+			// tags
+			{
+				Name:        "defined_tags",
+				Description: ColumnDescriptionDefinedTags,
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "freeform_tags",
+				Description: ColumnDescriptionFreefromTags,
+				Type:        proto.ColumnType_JSON,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "tags",
+				Description: ColumnDescriptionTags,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.From(monitoringAlarmTags),
+			},
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Id").Transform(ociRegionName),
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("CompartmentId"),
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+//// LIST FUNCTION
+
+func listMonitoringAlarms(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	compartment := d.EqualsQualString(matrixKeyCompartment)
+	logger.Debug("oci.listMonitoringAlarms", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.EqualsQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_monitoring_alarm.listMonitoringAlarms", "connection_error", err)
+		return nil, err
+	}
+
+	request := monitoring.ListAlarmsRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["lifecycle_state"] != nil {
+		request.LifecycleState = monitoring.AlarmLifecycleStateEnum(equalQuals["lifecycle_state"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.MonitoringClient.ListAlarms(ctx, request)
+		if err != nil {
+			logger.Error("oci_monitoring_alarm.listMonitoringAlarms", "api_error", err)
+			return nil, err
+		}
+
+		for _, alarm := range response.Items {
+			d.StreamListItem(ctx, alarm)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
+
+//// HYDRATE FUNCTION
+
+func getMonitoringAlarm(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	compartment := d.EqualsQualString(matrixKeyCompartment)
+	logger.Debug("oci.getMonitoringAlarm", "Compartment", compartment, "OCI_REGION", region)
+
+	var id string
+	if h.Item != nil {
+		id = *h.Item.(monitoring.AlarmSummary).Id
+	} else {
+		// Restrict the api call to only root compartment/ per region
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
+			return nil, nil
+		}
+		id = d.EqualsQuals["id"].GetStringValue()
+	}
+
+	// handle empty alarm id in get call
+	if strings.TrimSpace(id) == "" {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_monitoring_alarm.getMonitoringAlarm", "connection_error", err)
+		return nil, err
+	}
+
+	request := monitoring.GetAlarmRequest{
+		AlarmId: types.String(id),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.MonitoringClient.GetAlarm(ctx, request)
+	if err != nil {
+		logger.Error("oci_monitoring_alarm.getMonitoringAlarm", "api_error", err)
+		return nil, err
+	}
+
+	return response.Alarm, nil
+}
+
+//// TRANSFORM FUNCTION
+
+func monitoringAlarmTags(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	switch alarm := d.HydrateItem.(type) {
+	case monitoring.Alarm:
+		return extractTags(alarm.FreeformTags, alarm.DefinedTags), nil
+	case monitoring.AlarmSummary:
+		return extractTags(alarm.FreeformTags, alarm.DefinedTags), nil
+	}
+	return nil, nil
+}
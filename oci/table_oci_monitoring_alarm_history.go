@@ -0,0 +1,293 @@
+package oci
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMonitoringAlarmHistory(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_monitoring_alarm_history",
+		Description: "OCI Monitoring Alarm History",
+		List: &plugin.ListConfig{
+			ParentHydrate: listMonitoringAlarmHistoryAlarms,
+			Hydrate:       listMonitoringAlarmHistory,
+			Tags:          map[string]string{"service": "monitoring", "action": "GetAlarmHistory"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "alarm_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "alarm_history_type",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "timestamp",
+					Operators: []string{">", ">=", "=", "<", "<="},
+					Require:   plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "alarm_id",
+				Description: "The OCID of the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "alarm_name",
+				Description: "The configured name of the alarm.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The date and time the history entry was recorded.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.Timestamp.Time"),
+			},
+			{
+				Name:        "summary",
+				Description: "Description of the state change, e.g. The alarm state is FIRING.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Summary"),
+			},
+			{
+				Name:        "alarm_summary",
+				Description: "Customizable alarm summary (alarmSummary alarm message parameter).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.AlarmSummary"),
+			},
+			{
+				Name:        "timestamp_triggered",
+				Description: "The date and time the alarm transitioned to the state of the history entry.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Entry.TimestampTriggered.Time"),
+			},
+			{
+				Name:        "alarm_history_type",
+				Description: "The type of the history entries, e.g. STATE_HISTORY, STATE_TRANSITION_HISTORY, RULE_HISTORY or RULE_TRANSITION_HISTORY. Entries of all types are returned if not specified.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("alarm_history_type"),
+			},
+			{
+				Name:        "is_enabled",
+				Description: "Whether the alarm is enabled.",
+				Type:        proto.ColumnType_BOOL,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Entry.Summary"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type monitoringAlarmHistoryInfo struct {
+	Entry         monitoring.AlarmHistoryEntry
+	AlarmId       *string
+	AlarmName     *string
+	IsEnabled     *bool
+	CompartmentId *string
+	Region        string
+}
+
+//// LIST FUNCTIONS
+
+// listMonitoringAlarmHistoryAlarms lists the alarms whose history is listed. Unlike listMonitoringAlarms, it
+// does not push the limit of the query down, since the limit applies to the history entries.
+func listMonitoringAlarmHistoryAlarms(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	compartment := d.EqualsQualString(matrixKeyCompartment)
+	logger.Debug("oci.listMonitoringAlarmHistoryAlarms", "Compartment", compartment, "OCI_REGION", region)
+
+	alarmId := d.EqualsQualString("alarm_id")
+
+	// Restrict the get call of the given alarm to only root compartment/ per region
+	if alarmId != "" && !strings.HasPrefix(compartment, "ocid1.tenancy.") {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_monitoring_alarm_history.listMonitoringAlarmHistoryAlarms", "connection_error", err)
+		return nil, err
+	}
+
+	if alarmId != "" {
+		request := monitoring.GetAlarmRequest{
+			AlarmId: types.String(alarmId),
+			RequestMetadata: common.RequestMetadata{
+				RetryPolicy: getDefaultRetryPolicy(d.Connection),
+			},
+		}
+
+		response, err := session.MonitoringClient.GetAlarm(ctx, request)
+		if err != nil {
+			// the alarm is in another region
+			if ociErr, ok := err.(common.ServiceError); ok && ociErr.GetHTTPStatusCode() == 404 {
+				return nil, nil
+			}
+			logger.Error("oci_monitoring_alarm_history.listMonitoringAlarmHistoryAlarms", "api_error", err)
+			return nil, err
+		}
+
+		d.StreamListItem(ctx, monitoring.AlarmSummary{
+			Id:            response.Id,
+			DisplayName:   response.DisplayName,
+			CompartmentId: response.CompartmentId,
+			IsEnabled:     response.IsEnabled,
+		})
+		return nil, nil
+	}
+
+	request := monitoring.ListAlarmsRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.MonitoringClient.ListAlarms(ctx, request)
+		if err != nil {
+			logger.Error("oci_monitoring_alarm_history.listMonitoringAlarmHistoryAlarms", "api_error", err)
+			return nil, err
+		}
+
+		for _, alarm := range response.Items {
+			d.StreamListItem(ctx, alarm)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
+
+func listMonitoringAlarmHistory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	alarm := h.Item.(monitoring.AlarmSummary)
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_monitoring_alarm_history.listMonitoringAlarmHistory", "connection_error", err)
+		return nil, err
+	}
+
+	request := monitoring.GetAlarmHistoryRequest{
+		AlarmId: alarm.Id,
+		Limit:   types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	if d.EqualsQuals["alarm_history_type"] != nil {
+		request.AlarmHistorytype = monitoring.GetAlarmHistoryAlarmHistorytypeEnum(d.EqualsQualString("alarm_history_type"))
+	}
+
+	if d.Quals["timestamp"] != nil {
+		for _, q := range d.Quals["timestamp"].Quals {
+			timestamp := q.Value.GetTimestampValue().AsTime()
+			switch q.Operator {
+			case "=":
+				request.TimestampGreaterThanOrEqualTo = &common.SDKTime{Time: timestamp}
+				request.TimestampLessThan = &common.SDKTime{Time: timestamp.Add(time.Millisecond)}
+			case ">=", ">":
+				request.TimestampGreaterThanOrEqualTo = &common.SDKTime{Time: timestamp}
+			case "<":
+				request.TimestampLessThan = &common.SDKTime{Time: timestamp}
+			case "<=":
+				request.TimestampLessThan = &common.SDKTime{Time: timestamp.Add(time.Millisecond)}
+			}
+		}
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.MonitoringClient.GetAlarmHistory(ctx, request)
+		if err != nil {
+			logger.Error("oci_monitoring_alarm_history.listMonitoringAlarmHistory", "api_error", err)
+			return nil, err
+		}
+
+		for _, entry := range response.Entries {
+			d.StreamListItem(ctx, monitoringAlarmHistoryInfo{
+				Entry:         entry,
+				AlarmId:       alarm.Id,
+				AlarmName:     alarm.DisplayName,
+				IsEnabled:     response.IsEnabled,
+				CompartmentId: alarm.CompartmentId,
+				Region:        region,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, nil
+}
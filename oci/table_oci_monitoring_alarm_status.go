@@ -0,0 +1,238 @@
+package oci
+
+import (
+	"context"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMonitoringAlarmStatus(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_monitoring_alarm_status",
+		Description: "OCI Monitoring Alarm Status",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringAlarmStatuses,
+			Tags:    map[string]string{"service": "monitoring", "action": "ListAlarmsStatus"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "compartment_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "display_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "status",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_id",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "service_name",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "entity_id",
+					Require: plugin.Optional,
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "display_name",
+				Description: "The configured name of the alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmStatus.DisplayName"),
+			},
+			{
+				Name:        "id",
+				Description: "The OCID of the alarm.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmStatus.Id"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the alarm, e.g. FIRING, OK or SUSPENDED.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmStatus.Status"),
+			},
+			{
+				Name:        "severity",
+				Description: "The perceived severity of the alarm with regard to the affected system, e.g. CRITICAL, ERROR, WARNING or INFO.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmStatus.Severity"),
+			},
+			{
+				Name:        "timestamp_triggered",
+				Description: "The date and time the alarm transitioned to its current status.",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("AlarmStatus.TimestampTriggered.Time"),
+			},
+			{
+				Name:        "alarm_summary",
+				Description: "Customizable alarm summary (alarmSummary alarm message parameter).",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmStatus.AlarmSummary"),
+			},
+			{
+				Name:        "rule_name",
+				Description: "Identifier of the alarm's base values or override values that triggered the current status.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmStatus.RuleName"),
+			},
+			{
+				Name:        "suppression",
+				Description: "The configuration details for suppressing the alarm notifications.",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AlarmStatus.Suppression"),
+			},
+			{
+				Name:        "resource_id",
+				Description: "The OCID of a resource monitored by the alarm, used to filter the alarms.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("resource_id"),
+			},
+			{
+				Name:        "service_name",
+				Description: "The name of a service monitored by the alarm, used to filter the alarms.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("service_name"),
+			},
+			{
+				Name:        "entity_id",
+				Description: "The OCID of an entity monitored by the alarm, used to filter the alarms.",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("entity_id"),
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("AlarmStatus.DisplayName"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+// the alarm status summary does not include the compartment of the alarm
+type monitoringAlarmStatusInfo struct {
+	AlarmStatus   monitoring.AlarmStatusSummary
+	CompartmentId string
+	Region        string
+}
+
+//// LIST FUNCTION
+
+func listMonitoringAlarmStatuses(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	compartment := d.EqualsQualString(matrixKeyCompartment)
+	logger.Debug("oci.listMonitoringAlarmStatuses", "Compartment", compartment, "OCI_REGION", region)
+
+	equalQuals := d.EqualsQuals
+
+	// Return nil, if given compartment_id doesn't match
+	if equalQuals["compartment_id"] != nil && compartment != equalQuals["compartment_id"].GetStringValue() {
+		return nil, nil
+	}
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_monitoring_alarm_status.listMonitoringAlarmStatuses", "connection_error", err)
+		return nil, err
+	}
+
+	request := monitoring.ListAlarmsStatusRequest{
+		CompartmentId: types.String(compartment),
+		Limit:         types.Int(1000),
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	// Check for additional filters
+	if equalQuals["display_name"] != nil {
+		request.DisplayName = types.String(equalQuals["display_name"].GetStringValue())
+	}
+	if equalQuals["status"] != nil {
+		request.Status = monitoring.ListAlarmsStatusStatusEnum(equalQuals["status"].GetStringValue())
+	}
+	if equalQuals["resource_id"] != nil {
+		request.ResourceId = types.String(equalQuals["resource_id"].GetStringValue())
+	}
+	if equalQuals["service_name"] != nil {
+		request.ServiceName = types.String(equalQuals["service_name"].GetStringValue())
+	}
+	if equalQuals["entity_id"] != nil {
+		request.EntityId = types.String(equalQuals["entity_id"].GetStringValue())
+	}
+
+	limit := d.QueryContext.Limit
+	if d.QueryContext.Limit != nil {
+		if *limit < int64(*request.Limit) {
+			request.Limit = types.Int(int(*limit))
+		}
+	}
+
+	pagesLeft := true
+	for pagesLeft {
+		response, err := session.MonitoringClient.ListAlarmsStatus(ctx, request)
+		if err != nil {
+			logger.Error("oci_monitoring_alarm_status.listMonitoringAlarmStatuses", "api_error", err)
+			return nil, err
+		}
+
+		for _, status := range response.Items {
+			d.StreamListItem(ctx, monitoringAlarmStatusInfo{
+				AlarmStatus:   status,
+				CompartmentId: compartment,
+				Region:        region,
+			})
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+		if response.OpcNextPage != nil {
+			request.Page = response.OpcNextPage
+		} else {
+			pagesLeft = false
+		}
+	}
+
+	return nil, err
+}
@@ -0,0 +1,267 @@
+package oci
+
+import (
+	"context"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/oracle/oci-go-sdk/v65/monitoring"
+	"github.com/turbot/go-kit/types"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableMonitoringMetricData(_ context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "oci_monitoring_metric_data",
+		Description: "OCI Monitoring Metric Data",
+		List: &plugin.ListConfig{
+			Hydrate: listMonitoringMetricData,
+			Tags:    map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns: []*plugin.KeyColumn{
+				{
+					Name:    "namespace",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "query",
+					Require: plugin.Required,
+				},
+				{
+					Name:    "resolution",
+					Require: plugin.Optional,
+				},
+				{
+					Name:    "resource_group",
+					Require: plugin.Optional,
+				},
+				{
+					Name:      "start_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+				{
+					Name:      "end_time",
+					Require:   plugin.Optional,
+					Operators: []string{">", ">=", "=", "<", "<="},
+				},
+			},
+		},
+		GetMatrixItemFunc: BuildCompartementRegionList,
+		Columns: commonColumnsForAllResource([]*plugin.Column{
+			{
+				Name:        "namespace",
+				Description: "The source service or application emitting the metric, e.g. oci_computeagent.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "query",
+				Description: "The Monitoring Query Language (MQL) expression used to retrieve the metric data, e.g. CpuUtilization[1m].mean().",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromQual("query"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "timestamp",
+				Description: "The date and time associated with the value of the data point.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "value",
+				Description: "Numeric value of the data point, aggregated by the query.",
+				Type:        proto.ColumnType_DOUBLE,
+			},
+			{
+				Name:        "dimensions",
+				Description: "Qualifiers of the metric stream the data point belongs to, e.g. resourceId.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "metadata",
+				Description: "Properties describing the metric, such as its unit and display name.",
+				Type:        proto.ColumnType_JSON,
+			},
+			{
+				Name:        "resolution",
+				Description: "The time between calculated aggregation windows, e.g. 1m or 1h. Defaults to the interval of the query.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "resource_group",
+				Description: "The resource group of the metric.",
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "start_time",
+				Description: "The beginning of the time range of the query. Defaults to 24 hours before end_time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+			{
+				Name:        "end_time",
+				Description: "The end of the time range of the query. Defaults to the current time.",
+				Type:        proto.ColumnType_TIMESTAMP,
+			},
+
+			// Standard Steampipe columns
+			{
+				Name:        "title",
+				Description: ColumnDescriptionTitle,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Name"),
+			},
+
+			// Standard OCI columns
+			{
+				Name:        "region",
+				Description: ColumnDescriptionRegion,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "compartment_id",
+				Description: ColumnDescriptionCompartment,
+				Type:        proto.ColumnType_STRING,
+			},
+			{
+				Name:        "tenant_id",
+				Description: ColumnDescriptionTenantId,
+				Type:        proto.ColumnType_STRING,
+				Hydrate:     getTenantId,
+				Transform:   transform.FromValue(),
+			},
+		}),
+	}
+}
+
+type monitoringMetricDataInfo struct {
+	Namespace     *string
+	Name          *string
+	CompartmentId *string
+	Dimensions    map[string]string
+	Metadata      map[string]string
+	Resolution    *string
+	ResourceGroup *string
+	Timestamp     *time.Time
+	Value         *float64
+	StartTime     time.Time
+	EndTime       time.Time
+	Region        string
+}
+
+//// LIST FUNCTION
+
+func listMonitoringMetricData(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	logger := plugin.Logger(ctx)
+	region := d.EqualsQualString(matrixKeyRegion)
+	compartment := d.EqualsQualString(matrixKeyCompartment)
+	logger.Debug("listMonitoringMetricData", "Compartment", compartment, "OCI_REGION", region)
+
+	// Create Session
+	session, err := monitoringService(ctx, d, region)
+	if err != nil {
+		logger.Error("oci_monitoring_metric_data.listMonitoringMetricData", "connection_error", err)
+		return nil, err
+	}
+
+	// By default, fetch the data points of the last 24 hours
+	endTime := getMonitoringMetricDataTime(d, "end_time", time.Now())
+	startTime := getMonitoringMetricDataTime(d, "start_time", endTime.Add(-24*time.Hour))
+	if !startTime.Before(endTime) {
+		return nil, nil
+	}
+
+	details := monitoring.SummarizeMetricsDataDetails{
+		Namespace: types.String(d.EqualsQualString("namespace")),
+		Query:     types.String(d.EqualsQualString("query")),
+		StartTime: &common.SDKTime{Time: startTime},
+		EndTime:   &common.SDKTime{Time: endTime},
+	}
+	if resolution := d.EqualsQualString("resolution"); resolution != "" {
+		details.Resolution = types.String(resolution)
+	}
+	if resourceGroup := d.EqualsQualString("resource_group"); resourceGroup != "" {
+		details.ResourceGroup = types.String(resourceGroup)
+	}
+
+	request := monitoring.SummarizeMetricsDataRequest{
+		CompartmentId:               types.String(compartment),
+		SummarizeMetricsDataDetails: details,
+		RequestMetadata: common.RequestMetadata{
+			RetryPolicy: getDefaultRetryPolicy(d.Connection),
+		},
+	}
+
+	response, err := session.MonitoringClient.SummarizeMetricsData(ctx, request)
+	if err != nil {
+		logger.Error("oci_monitoring_metric_data.listMonitoringMetricData", "api_error", err)
+		return nil, err
+	}
+
+	for _, item := range response.Items {
+		for _, datapoint := range item.AggregatedDatapoints {
+			row := monitoringMetricDataInfo{
+				Namespace:     item.Namespace,
+				Name:          item.Name,
+				CompartmentId: item.CompartmentId,
+				Dimensions:    item.Dimensions,
+				Metadata:      item.Metadata,
+				Resolution:    item.Resolution,
+				ResourceGroup: item.ResourceGroup,
+				Value:         datapoint.Value,
+				StartTime:     startTime,
+				EndTime:       endTime,
+				Region:        region,
+			}
+			if datapoint.Timestamp != nil {
+				row.Timestamp = &datapoint.Timestamp.Time
+			}
+			d.StreamListItem(ctx, row)
+
+			// Context can be cancelled due to manual cancellation or the limit has been hit
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getMonitoringMetricDataTime returns the time of the start_time or end_time column of the query, i.e. the
+// default time moved to the closest time matching the quals of the column
+func getMonitoringMetricDataTime(d *plugin.QueryData, column string, defaultTime time.Time) time.Time {
+	result := defaultTime
+	if d.Quals[column] == nil {
+		return result
+	}
+	for _, q := range d.Quals[column].Quals {
+		value := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case "=":
+			return value
+		case ">=":
+			if result.Before(value) {
+				result = value
+			}
+		case ">":
+			if !result.After(value) {
+				result = value.Add(time.Microsecond)
+			}
+		case "<=":
+			if result.After(value) {
+				result = value
+			}
+		case "<":
+			if !result.Before(value) {
+				result = value.Add(-time.Microsecond)
+			}
+		}
+	}
+	return result
+}
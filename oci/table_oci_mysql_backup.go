@@ -20,6 +20,7 @@ func tableMySQLBackup(_ context.Context) *plugin.Table {
 		Description: "OCI MySQL Backup",
 		List: &plugin.ListConfig{
 			Hydrate: listMySQLBackups,
+			Tags:    map[string]string{"service": "mysql", "action": "ListBackups"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -50,8 +51,15 @@ func tableMySQLBackup(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getMySQLBackup,
+			Tags:       map[string]string{"service": "mysql", "action": "GetBackup"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getCompartmentPath,
+				Depends: []plugin.HydrateFunc{getMySQLBackup},
+			},
+		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -270,7 +278,7 @@ func getMySQLBackup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
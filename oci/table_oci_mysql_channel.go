@@ -20,6 +20,7 @@ func tableMySQLChannel(_ context.Context) *plugin.Table {
 		Description: "OCI MySQL Channel",
 		List: &plugin.ListConfig{
 			Hydrate: listMySQLChannels,
+			Tags:    map[string]string{"service": "mysql", "action": "ListChannels"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -46,8 +47,15 @@ func tableMySQLChannel(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getMySQLChannel,
+			Tags:       map[string]string{"service": "mysql", "action": "GetChannel"},
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getCompartmentPath,
+				Depends: []plugin.HydrateFunc{getMySQLChannel},
+			},
+		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -234,7 +242,7 @@ func getMySQLChannel(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -21,9 +21,11 @@ func tableMySQLConfiguration(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getMySQLConfiguration,
+			Tags:       map[string]string{"service": "mysql", "action": "GetConfiguration"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listMySQLConfigurations,
+			Tags:    map[string]string{"service": "mysql", "action": "ListConfigurations"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -48,6 +50,12 @@ func tableMySQLConfiguration(_ context.Context) *plugin.Table {
 			},
 		},
 		GetMatrixItemFunc: BuildCompartmentList,
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:    getCompartmentPath,
+				Depends: []plugin.HydrateFunc{getTenantId},
+			},
+		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -168,7 +176,7 @@ func listMySQLConfigurations(ctx context.Context, d *plugin.QueryData, _ *plugin
 	}
 
 	// Restrict the api call to only root compartment
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -227,7 +235,7 @@ func getMySQLConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.H
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
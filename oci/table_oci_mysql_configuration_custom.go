@@ -21,9 +21,11 @@ func tableMySQLConfigurationCustom(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getCustomConfiguration,
+			Tags:       map[string]string{"service": "mysql", "action": "GetConfiguration"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listMySQLCustomConfigurations,
+			Tags:    map[string]string{"service": "mysql", "action": "ListConfigurations"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -234,7 +236,7 @@ func getCustomConfiguration(ctx context.Context, d *plugin.QueryData, h *plugin.
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
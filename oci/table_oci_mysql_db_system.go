@@ -20,6 +20,7 @@ func tableMySQLDBSystem(_ context.Context) *plugin.Table {
 		Description: "OCI MySQL DB System",
 		List: &plugin.ListConfig{
 			Hydrate: listMySQLDBSystems,
+			Tags:    map[string]string{"service": "mysql", "action": "ListDbSystems"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -50,8 +51,9 @@ func tableMySQLDBSystem(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getMySQLDBSystem,
+			Tags:       map[string]string{"service": "mysql", "action": "GetDbSystem"},
 		},
-		GetMatrixItemFunc: BuildCompartementRegionList,
+		GetMatrixItemFunc: BuildCompartementRegionListForResourceType("MysqlDbSystem"),
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
 				Name:        "display_name",
@@ -347,7 +349,7 @@ func getMySQLDBSystem(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -19,6 +19,8 @@ func tableOciMySQLDBSystemMetricConnectionsDaily(_ context.Context) *plugin.Tabl
 		List: &plugin.ListConfig{
 			ParentHydrate: listMySQLDBSystems,
 			Hydrate:       listMySQLDBSystemMetricConnectionsDaily,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -19,6 +19,8 @@ func tableOciMySQLDBSystemMetricConnectionsHourly(_ context.Context) *plugin.Tab
 		List: &plugin.ListConfig{
 			ParentHydrate: listMySQLDBSystems,
 			Hydrate:       listMySQLDBSystemMetricConnectionsHourly,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -19,6 +19,8 @@ func tableOciMySQLDBSystemMetricCpuUtilization(_ context.Context) *plugin.Table
 		List: &plugin.ListConfig{
 			ParentHydrate: listMySQLDBSystems,
 			Hydrate:       listMySQLDBSystemMetricCpuUtilization,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
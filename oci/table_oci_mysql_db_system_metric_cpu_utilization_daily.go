@@ -19,6 +19,8 @@ func tableOciMySQLDBSystemMetricCpuUtilizationDaily(_ context.Context) *plugin.T
 		List: &plugin.ListConfig{
 			ParentHydrate: listMySQLDBSystems,
 			Hydrate:       listMySQLDBSystemMetricCpuUtilizationDaily,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -18,6 +18,7 @@ func tableOciMySQLHeatWaveCluster(ctx context.Context) *plugin.Table {
 		Description: "OCI MySQL heat wave cluster",
 		List: &plugin.ListConfig{
 			Hydrate:       listMySQLHeatWaveCluster,
+			Tags:          map[string]string{"service": "mysql", "action": "GetHeatWaveCluster"},
 			ParentHydrate: listMySQLDBSystems,
 			KeyColumns: plugin.KeyColumnSlice{
 				{
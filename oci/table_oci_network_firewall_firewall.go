@@ -22,9 +22,11 @@ func tableNetworkFirewall(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getNetworkFirewall,
+			Tags:       map[string]string{"service": "networkfirewall", "action": "GetNetworkFirewall"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listNetworkFirewalls,
+			Tags:    map[string]string{"service": "networkfirewall", "action": "ListNetworkFirewalls"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -227,7 +229,7 @@ func getNetworkFirewall(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 		id = *h.Item.(networkfirewall.NetworkFirewallSummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -22,9 +22,11 @@ func tableNetworkFirewallPolicy(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getNetworkFirewallPolicy,
+			Tags:       map[string]string{"service": "networkfirewall", "action": "GetNetworkFirewallPolicy"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listNetworkFirewallPolicies,
+			Tags:    map[string]string{"service": "networkfirewall", "action": "ListNetworkFirewallPolicies"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -244,7 +246,7 @@ func getNetworkFirewallPolicy(ctx context.Context, d *plugin.QueryData, h *plugi
 		id = *h.Item.(networkfirewall.NetworkFirewallPolicySummary).Id
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -292,7 +294,7 @@ func listNetworkFirewallPolicyApplications(ctx context.Context, d *plugin.QueryD
 		}
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -351,7 +353,7 @@ func listNetworkFirewallPolicyDecryptionProfiles(ctx context.Context, d *plugin.
 		}
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -410,7 +412,7 @@ func listNetworkFirewallPolicyDecryptionRules(ctx context.Context, d *plugin.Que
 		}
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -470,7 +472,7 @@ func listNetworkFirewallPolicyMappedSecrets(ctx context.Context, d *plugin.Query
 		}
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -529,7 +531,7 @@ func listNetworkFirewallPolicySecurityRules(ctx context.Context, d *plugin.Query
 		}
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -588,7 +590,7 @@ func listNetworkFirewallPolicyAddresses(ctx context.Context, d *plugin.QueryData
 		}
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -647,7 +649,7 @@ func listNetworkFirewallPolicyURLs(ctx context.Context, d *plugin.QueryData, h *
 		}
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
@@ -21,9 +21,11 @@ func tableNoSQLTable(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getNoSQLTable,
+			Tags:       map[string]string{"service": "nosql", "action": "GetTable"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listNoSQLTables,
+			Tags:    map[string]string{"service": "nosql", "action": "ListTables"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -243,7 +245,7 @@ func getNoSQLTable(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		id = *h.Item.(nosql.TableSummary).Id
 	} else {
 		// Restrict the api call to only root compartment
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
@@ -18,6 +18,8 @@ func tableOciNoSQLTableMetricReadThrottleCount(_ context.Context) *plugin.Table
 		List: &plugin.ListConfig{
 			ParentHydrate: listNoSQLTables,
 			Hydrate:       listNoSQLTableMetricReadThrottleCount,
+			Tags:          map[string]string{"service": "monitoring", "action": "SummarizeMetricsData"},
+			KeyColumns:    monitoringMetricKeyColumns(),
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
 		Columns: commonColumnsForAllResource(MonitoringMetricColumns(
@@ -28,6 +28,7 @@ func tableObjectStorageBucket(_ context.Context) *plugin.Table {
 		// },
 		List: &plugin.ListConfig{
 			Hydrate: listObjectStorageBuckets,
+			Tags:    map[string]string{"service": "objectstorage", "action": "ListBuckets"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -295,7 +296,7 @@ func getObjectStorageBucket(ctx context.Context, d *plugin.QueryData, h *plugin.
 	} else {
 		bucketName = d.EqualsQuals["name"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
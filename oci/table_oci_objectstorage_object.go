@@ -20,6 +20,7 @@ func tableObjectStorageObject(_ context.Context) *plugin.Table {
 		// Object can have same name in two different buckets, regions or compartments, leading to duplicate result in get call
 		List: &plugin.ListConfig{
 			Hydrate:       listObjectStorageObjects,
+			Tags:          map[string]string{"service": "objectstorage", "action": "ListObjects"},
 			ParentHydrate: listObjectStorageBuckets,
 		},
 		GetMatrixItemFunc: BuildCompartementRegionList,
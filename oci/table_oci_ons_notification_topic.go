@@ -21,9 +21,11 @@ func tableOnsNotificationTopic(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("topic_id"),
 			Hydrate:    getOnsNotificationTopic,
+			Tags:       map[string]string{"service": "ons", "action": "GetTopic"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listOnsNotificationTopics,
+			Tags:    map[string]string{"service": "ons", "action": "ListTopics"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -218,7 +220,7 @@ func getOnsNotificationTopic(ctx context.Context, d *plugin.QueryData, h *plugin
 	logger.Debug("oci.getOnsNotificationTopic", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -19,12 +19,16 @@ func tableOnsSubscription(_ context.Context) *plugin.Table {
 		Name:        "oci_ons_subscription",
 		Description: "OCI Ons Subscription",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
-			Hydrate:           getOnsSubscription,
+			KeyColumns: plugin.SingleColumn("id"),
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"}),
+			},
+			Hydrate: getOnsSubscription,
+			Tags:    map[string]string{"service": "ons", "action": "GetSubscription"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listOnsSubscriptions,
+			Tags:    map[string]string{"service": "ons", "action": "ListSubscriptions"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -213,7 +217,7 @@ func getOnsSubscription(ctx context.Context, d *plugin.QueryData, h *plugin.Hydr
 	logger.Debug("oci.getOnsSubscription", "Compartment", compartment, "OCI_REGION", region)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
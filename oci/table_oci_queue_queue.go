@@ -25,9 +25,11 @@ func tableQueueQueue(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getQueue,
+			Tags:       map[string]string{"service": "queue", "action": "GetQueue"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listQueues,
+			Tags:    map[string]string{"service": "queue", "action": "ListQueues"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -249,7 +251,7 @@ func getQueue(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 	} else {
 		id = d.EqualsQuals["id"].GetStringValue()
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 	}
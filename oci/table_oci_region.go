@@ -17,6 +17,7 @@ func tableIdentityRegion(_ context.Context) *plugin.Table {
 		Description: "OCI Region",
 		List: &plugin.ListConfig{
 			Hydrate: listRegions,
+			Tags:    map[string]string{"service": "identity", "action": "ListRegions"},
 		},
 		Columns: commonColumnsForAllResource([]*plugin.Column{
 			{
@@ -21,6 +21,7 @@ func tableResourceSearch(_ context.Context) *plugin.Table {
 		List: &plugin.ListConfig{
 			KeyColumns: plugin.AnyColumn([]string{"query", "text"}),
 			Hydrate:    listResourceSearch,
+			Tags:       map[string]string{"service": "resourcesearch", "action": "SearchResources"},
 		},
 		GetMatrixItemFunc: BuildRegionList,
 		Columns: commonColumnsForAllResource([]*plugin.Column{
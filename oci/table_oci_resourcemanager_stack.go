@@ -21,9 +21,11 @@ func tableOciResourceManagerStack(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getResourceManagerStack,
+			Tags:       map[string]string{"service": "resourcemanager", "action": "GetStack"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listResourceManagerStacks,
+			Tags:    map[string]string{"service": "resourcemanager", "action": "ListStacks"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -236,7 +238,7 @@ func getResourceManagerStack(ctx context.Context, d *plugin.QueryData, h *plugin
 		id = *h.Item.(resourcemanager.StackSummary).Id
 	} else {
 		// Restrict the api call to only root compartment
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
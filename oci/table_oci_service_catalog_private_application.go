@@ -21,9 +21,11 @@ func tableOciServiceCatalogPrivateApplication(_ context.Context) *plugin.Table {
 		Get: &plugin.GetConfig{
 			KeyColumns: plugin.SingleColumn("id"),
 			Hydrate:    getServiceCatalogPrivateApplication,
+			Tags:       map[string]string{"service": "servicecatalog", "action": "GetPrivateApplication"},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listServiceCatalogPrivateApplications,
+			Tags:    map[string]string{"service": "servicecatalog", "action": "ListPrivateApplications"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "display_name",
@@ -207,7 +209,7 @@ func getServiceCatalogPrivateApplication(ctx context.Context, d *plugin.QueryDat
 	compartment := d.EqualsQualString(matrixKeyCompartment)
 
 	// Restrict the api call to only root compartment/ per region
-	if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+	if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 		return nil, nil
 	}
 
@@ -19,12 +19,16 @@ func tableVaultSecret(_ context.Context) *plugin.Table {
 		Name:        "oci_vault_secret",
 		Description: "OCI Vault Secret",
 		Get: &plugin.GetConfig{
-			KeyColumns:        plugin.SingleColumn("id"),
-			Hydrate:           getVaultSecret,
-			ShouldIgnoreError: isNotFoundError([]string{"400", "404"}),
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getVaultSecret,
+			Tags:       map[string]string{"service": "vault", "action": "GetSecret"},
+			IgnoreConfig: &plugin.IgnoreConfig{
+				ShouldIgnoreErrorFunc: isNotFoundError([]string{"400", "404"}),
+			},
 		},
 		List: &plugin.ListConfig{
 			Hydrate: listVaultSecrets,
+			Tags:    map[string]string{"service": "vault", "action": "ListSecrets"},
 			KeyColumns: []*plugin.KeyColumn{
 				{
 					Name:    "compartment_id",
@@ -256,7 +260,7 @@ func getVaultSecret(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 		id = *i.Id
 	} else {
 		// Restrict the api call to only root compartment/ per region
-		if !strings.HasPrefix(compartment, "ocid1.tenancy.oc1") {
+		if !strings.HasPrefix(compartment, "ocid1.tenancy.") {
 			return nil, nil
 		}
 		id = d.EqualsQuals["id"].GetStringValue()
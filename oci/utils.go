@@ -4,11 +4,12 @@ import (
 	"context"
 	"math"
 	"math/rand"
-	"slices"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-hclog"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 	"github.com/oracle/oci-go-sdk/v65/objectstorage"
 	"github.com/turbot/go-kit/types"
@@ -53,12 +54,19 @@ func getNamespace(ctx context.Context, d *plugin.QueryData, region string) (*nam
 	return name, err
 }
 
+// retryLogger logs the retries of the API calls. The retry policies are built from the connection
+// without a context, so the plugin logger is saved here when the plugin is created.
+var retryLogger = hclog.NewNullLogger()
+
 // https://github.com/oracle/oci-go-sdk/blob/master/example/helpers/helper.go#L127
 func getDefaultRetryPolicy(connection *plugin.Connection) *oci_common.RetryPolicy {
 	// how many times to do the retry
 	attempts := uint(9)
 	minRetryDelay := 25 * time.Millisecond
 
+	// total time budget of a call, including the retries; 0 means no limit
+	var maxRetryDuration time.Duration
+
 	// Get config details for maximum error attempt and minimum delay time
 	config := GetConfig(connection)
 	if config.MaxErrorRetryAttempts != nil {
@@ -69,6 +77,10 @@ func getDefaultRetryPolicy(connection *plugin.Connection) *oci_common.RetryPolic
 		minRetryDelay = time.Duration(*config.MinErrorRetryDelay) * time.Millisecond
 	}
 
+	if config.MaxErrorRetryDuration != nil {
+		maxRetryDuration = time.Duration(*config.MaxErrorRetryDuration) * time.Second
+	}
+
 	/*
 		429	TooManyRequests	You have issued too many requests to the
 		Oracle Cloud Infrastructure APIs in too short of an amount of time.	Yes, with backoff.
@@ -77,18 +89,28 @@ func getDefaultRetryPolicy(connection *plugin.Connection) *oci_common.RetryPolic
 
 		503	ServiceUnavailable	The service is currently unavailable.	Yes, with backoff.
 		https: //docs.oracle.com/en-us/iaas/Content/API/References/apierrors.htm
+
+		Additional HTTP status codes (e.g. 502, 504), service error codes (e.g. IncorrectState)
+		and network timeouts can be made retryable with the retryable_errors config argument.
 	*/
+	retryableErrors := append([]string{"429", "500", "503"}, config.RetryableErrors...)
+
 	retryOnResponseCodes := func(r oci_common.OCIOperationResponse) bool {
-		if r.Response != nil && r.Response.HTTPResponse() != nil {
-			statusCode := strconv.Itoa(r.Response.HTTPResponse().StatusCode)
-			return (r.Error != nil && slices.Contains([]string{"429", "500", "503"}, statusCode))
+		if r.Error == nil || !isRetryableError(r, retryableErrors) {
+			return false
+		}
+
+		// stop retrying once the time budget of the call is spent
+		if maxRetryDuration > 0 && !r.InitialAttemptTime.IsZero() && time.Since(r.InitialAttemptTime) >= maxRetryDuration {
+			retryLogger.Warn("getDefaultRetryPolicy", "status", "giving up", "attempts", r.AttemptNumber, "duration", time.Since(r.InitialAttemptTime).Round(time.Millisecond), "opc_request_id", getOpcRequestId(r), "error", r.Error)
+			return false
 		}
-		return false
+		return true
 	}
-	return getExponentialBackoffRetryPolicy(attempts, minRetryDelay, retryOnResponseCodes)
+	return getExponentialBackoffRetryPolicy(attempts, minRetryDelay, maxRetryDuration, retryOnResponseCodes)
 }
 
-func getExponentialBackoffRetryPolicy(n uint, minRetryDelay time.Duration, fn func(r oci_common.OCIOperationResponse) bool) *oci_common.RetryPolicy {
+func getExponentialBackoffRetryPolicy(n uint, minRetryDelay time.Duration, maxRetryDuration time.Duration, fn func(r oci_common.OCIOperationResponse) bool) *oci_common.RetryPolicy {
 	// the duration between each retry operation, you might want to waite longer each time the retry fails
 	exponentialBackoff := func(r oci_common.OCIOperationResponse) time.Duration {
 
@@ -101,17 +123,98 @@ func getExponentialBackoffRetryPolicy(n uint, minRetryDelay time.Duration, fn fu
 		// minDelay and (minDelay * 3^retrycount) * jitter on each failure
 		// as example (23.25ms, 63ms, 238.5ms, 607.4ms, 2s, 5.22s, 20.31s...) up to max.
 		// Maximum delay should not be more than 3 min
-		maxDelayTime := time.Duration(int(float64(int(minRetryDelay.Nanoseconds())*int(math.Pow(3, float64(r.AttemptNumber)))) * jitter))
-		if maxDelayTime > time.Duration(3*time.Minute) {
-			return time.Duration(3 * time.Minute)
+		delay := time.Duration(int(float64(int(minRetryDelay.Nanoseconds())*int(math.Pow(3, float64(r.AttemptNumber)))) * jitter))
+
+		// The service knows best when it will accept requests again
+		if retryAfter := getRetryAfter(r); retryAfter > delay {
+			delay = retryAfter
 		}
 
-		return maxDelayTime
+		if delay > time.Duration(3*time.Minute) {
+			delay = time.Duration(3 * time.Minute)
+		}
+
+		// Do not sleep past the time budget of the call
+		if maxRetryDuration > 0 && !r.InitialAttemptTime.IsZero() {
+			if remaining := maxRetryDuration - time.Since(r.InitialAttemptTime); delay > remaining {
+				delay = max(remaining, 0)
+			}
+		}
+
+		retryLogger.Debug("getDefaultRetryPolicy", "status", "retrying", "attempt", r.AttemptNumber, "delay", delay, "http_status", getResponseStatusCode(r), "code", getServiceErrorCode(r), "opc_request_id", getOpcRequestId(r), "error", r.Error)
+
+		return delay
 	}
 	policy := oci_common.NewRetryPolicy(n, fn, exponentialBackoff)
 	return &policy
 }
 
+// isRetryableError checks the error of an operation against a list of HTTP status codes, service
+// error codes and the "timeout" keyword for network timeouts
+func isRetryableError(r oci_common.OCIOperationResponse, retryableErrors []string) bool {
+	statusCode := getResponseStatusCode(r)
+	errorCode := getServiceErrorCode(r)
+
+	for _, retryable := range retryableErrors {
+		switch {
+		case statusCode != 0 && retryable == strconv.Itoa(statusCode):
+			return true
+		case errorCode != "" && strings.EqualFold(retryable, errorCode):
+			return true
+		case strings.EqualFold(retryable, "timeout") && oci_common.IsNetworkError(r.Error):
+			return true
+		}
+	}
+	return false
+}
+
+func getResponseStatusCode(r oci_common.OCIOperationResponse) int {
+	if serviceError, ok := oci_common.IsServiceError(r.Error); ok {
+		return serviceError.GetHTTPStatusCode()
+	}
+	if r.Response != nil && r.Response.HTTPResponse() != nil {
+		return r.Response.HTTPResponse().StatusCode
+	}
+	return 0
+}
+
+func getServiceErrorCode(r oci_common.OCIOperationResponse) string {
+	if serviceError, ok := oci_common.IsServiceError(r.Error); ok {
+		return serviceError.GetCode()
+	}
+	return ""
+}
+
+// getOpcRequestId returns the request id assigned by OCI, which Oracle support asks for when
+// investigating failing calls
+func getOpcRequestId(r oci_common.OCIOperationResponse) string {
+	if serviceError, ok := oci_common.IsServiceError(r.Error); ok && serviceError.GetOpcRequestID() != "" {
+		return serviceError.GetOpcRequestID()
+	}
+	if r.Response != nil && r.Response.HTTPResponse() != nil {
+		return r.Response.HTTPResponse().Header.Get("opc-request-id")
+	}
+	return ""
+}
+
+// getRetryAfter parses the Retry-After header of the response, given either in seconds or as an HTTP date
+func getRetryAfter(r oci_common.OCIOperationResponse) time.Duration {
+	if r.Response == nil || r.Response.HTTPResponse() == nil {
+		return 0
+	}
+	value := strings.TrimSpace(r.Response.HTTPResponse().Header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
 // Extract OCI region name from the resource id
 func ociRegionName(_ context.Context, d *transform.TransformData) (interface{}, error) {
 	id := types.SafeString(d.Value)